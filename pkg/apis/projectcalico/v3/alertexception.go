@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindAlertException     = "AlertException"
+	KindAlertExceptionList = "AlertExceptionList"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertException suppresses the security alerts that match its specification, optionally only
+// for a limited time window.
+type AlertException struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the AlertException.
+	Spec AlertExceptionSpec `json:"spec,omitempty"`
+}
+
+// AlertExceptionSpec contains the specification for an alert exception resource.
+type AlertExceptionSpec struct {
+	// The description is displayed by the UI.
+	Description string `json:"description" validate:"required"`
+
+	// StartTime defines the start time from which this alert exception will take effect.
+	// If omitted, the exception takes effect immediately.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime defines the end time at which this alert exception will expire.
+	// If omitted, the alert exception does not expire.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Selector is a Calico label selector expression that picks out the endpoints to which
+	// the exception applies.  If omitted, the exception applies to all endpoints.
+	// +optional
+	Selector string `json:"selector,omitempty" validate:"omitempty,selector"`
+
+	// AlertName is the name of the alert to suppress.  If omitted, alerts of any name are
+	// suppressed.
+	// +optional
+	AlertName string `json:"alertName,omitempty" validate:"omitempty,name"`
+
+	// AlertNamespace is the namespace of the alerts to suppress.  If omitted, alerts in any
+	// namespace are suppressed.
+	// +optional
+	AlertNamespace string `json:"alertNamespace,omitempty" validate:"omitempty,name"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertExceptionList contains a list of AlertException resources.
+type AlertExceptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []AlertException `json:"items"`
+}
+
+// NewAlertException creates a new (zeroed) AlertException struct with the TypeMetadata
+// initialized to the current version.
+func NewAlertException() *AlertException {
+	return &AlertException{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindAlertException,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewAlertExceptionList creates a new (zeroed) AlertExceptionList struct with the TypeMetadata
+// initialized to the current version.
+func NewAlertExceptionList() *AlertExceptionList {
+	return &AlertExceptionList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindAlertExceptionList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
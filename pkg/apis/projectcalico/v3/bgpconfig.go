@@ -83,6 +83,9 @@ type BGPConfigurationSpec struct {
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=65535
 	ListenPort uint16 `json:"listenPort,omitempty" validate:"omitempty,gt=0" confignamev1:"listen_port"`
+
+	// CommunityAdvertisements attaches BGP communities to the routes advertised for the specified CIDRs.
+	CommunityAdvertisements []BGPCommunityAdvertisement `json:"communityAdvertisements,omitempty" validate:"omitempty,dive"`
 }
 
 // ServiceLoadBalancerIPBlock represents a single allowed LoadBalancer IP CIDR block.
@@ -123,6 +126,33 @@ type PrefixAdvertisement struct {
 	Communities []string `json:"communities,omitempty" validate:"required"`
 }
 
+// Well-known BGP communities, as defined in RFC 1997 and RFC 3765.
+const (
+	BGPCommunityNoExport          = "65535:65281"
+	BGPCommunityNoAdvertise       = "65535:65282"
+	BGPCommunityNoExportSubconfed = "65535:65283"
+	BGPCommunityNoPeer            = "65535:65284"
+)
+
+type BGPCommunityAction string
+
+const (
+	BGPCommunityActionAdd BGPCommunityAction = "Add"
+	BGPCommunityActionSet BGPCommunityAction = "Set"
+)
+
+// BGPCommunityAdvertisement attaches BGP communities to the routes advertised for a CIDR.
+type BGPCommunityAdvertisement struct {
+	// CIDR of the advertised routes to which the communities are attached.
+	CIDR string `json:"cidr,omitempty" validate:"required,net"`
+	// Communities is a list of community values of format `aa:nn` or `aa:nn:mm`, or well-known community
+	// names such as `no-export`.
+	Communities []string `json:"communities,omitempty" validate:"required,dive,bgpCommunity"`
+	// Action determines whether the communities are added to those already on the route ("Add") or
+	// replace them ("Set").  [Default: Add]
+	Action BGPCommunityAction `json:"action,omitempty" validate:"omitempty,oneof=Add Set"`
+}
+
 // New BGPConfiguration creates a new (zeroed) BGPConfiguration struct with the TypeMetadata
 // initialized to the current version.
 func NewBGPConfiguration() *BGPConfiguration {
@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindBGPFilter     = "BGPFilter"
+	KindBGPFilterList = "BGPFilterList"
+)
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BGPFilterList is a list of BGPFilter resources.
+type BGPFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []BGPFilter `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BGPFilter contains a set of rules that control which routes are imported from and exported to the BGP peers
+// that reference it in their Filters.
+type BGPFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec BGPFilterSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// BGPFilterSpec contains the IngressRules and EgressRules of a BGPFilter resource.  The rules are evaluated
+// in order and the first rule that matches a route determines the action taken.
+type BGPFilterSpec struct {
+	// The ordered set of rules applied to routes received from the BGP peers that use this filter.
+	IngressRules []BGPFilterRule `json:"ingressRules,omitempty" validate:"omitempty,dive"`
+
+	// The ordered set of rules applied to routes advertised to the BGP peers that use this filter.
+	EgressRules []BGPFilterRule `json:"egressRules,omitempty" validate:"omitempty,dive"`
+}
+
+// BGPFilterRule defines a BGP filter rule consisting of a CIDR match and an action to take on matching routes.
+// +kubebuilder:validation:XValidation:rule="!has(self.cidr) || has(self.matchOperator)",message="matchOperator must be set when cidr is set"
+type BGPFilterRule struct {
+	// The CIDR that routes are compared against, using MatchOperator.  If not set, the rule matches all routes.
+	CIDR string `json:"cidr,omitempty" validate:"omitempty,net"`
+
+	// How routes are compared against CIDR.  "In" matches routes that are within CIDR and "NotIn" matches
+	// routes that are not.  Must be set when CIDR is set.
+	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty" validate:"omitempty,oneof=In NotIn"`
+
+	// The source of the routes that the rule matches.  If set to "RemotePeers", the rule only matches routes
+	// learned from BGP peers.  If not set, the rule matches routes from all sources.
+	Source BGPFilterMatchSource `json:"source,omitempty" validate:"omitempty,oneof=RemotePeers"`
+
+	// The action to take on routes that match the rule.
+	Action BGPFilterAction `json:"action" validate:"oneof=Accept Reject"`
+}
+
+type BGPFilterMatchOperator string
+
+const (
+	BGPFilterMatchOperatorIn    BGPFilterMatchOperator = "In"
+	BGPFilterMatchOperatorNotIn BGPFilterMatchOperator = "NotIn"
+)
+
+type BGPFilterMatchSource string
+
+const (
+	BGPFilterSourceRemotePeers BGPFilterMatchSource = "RemotePeers"
+)
+
+type BGPFilterAction string
+
+const (
+	BGPFilterActionAccept BGPFilterAction = "Accept"
+	BGPFilterActionReject BGPFilterAction = "Reject"
+)
+
+// NewBGPFilter creates a new (zeroed) BGPFilter struct with the TypeMetadata initialised to the current
+// version.
+func NewBGPFilter() *BGPFilter {
+	return &BGPFilter{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindBGPFilter,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewBGPFilterList creates a new (zeroed) BGPFilterList struct with the TypeMetadata initialised to the current
+// version.
+func NewBGPFilterList() *BGPFilterList {
+	return &BGPFilterList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindBGPFilterList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// Validate returns an error if any of the filter's rules sets a CIDR without a MatchOperator.
+func (f *BGPFilter) Validate() error {
+	for i, r := range f.Spec.IngressRules {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("BGPFilter(%s) ingress rule %d: %v", f.Name, i, err)
+		}
+	}
+	for i, r := range f.Spec.EgressRules {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("BGPFilter(%s) egress rule %d: %v", f.Name, i, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the rule sets a CIDR without a MatchOperator.
+func (r BGPFilterRule) Validate() error {
+	if r.CIDR != "" && r.MatchOperator == "" {
+		return fmt.Errorf("matchOperator must be set when cidr is set")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("BGPFilter.Validate",
+	func(spec BGPFilterSpec, expectErr bool) {
+		f := BGPFilter{ObjectMeta: metav1.ObjectMeta{Name: "filter"}, Spec: spec}
+		expectValidationResult(f.Validate(), expectErr)
+	},
+	Entry("no rules", BGPFilterSpec{}, false),
+	Entry("rule without a CIDR", BGPFilterSpec{
+		IngressRules: []BGPFilterRule{{Action: BGPFilterActionReject}},
+	}, false),
+	Entry("rule with a CIDR and match operator", BGPFilterSpec{
+		IngressRules: []BGPFilterRule{{CIDR: "10.0.0.0/8", MatchOperator: BGPFilterMatchOperatorIn, Action: BGPFilterActionAccept}},
+		EgressRules:  []BGPFilterRule{{CIDR: "10.1.0.0/16", MatchOperator: BGPFilterMatchOperatorNotIn, Action: BGPFilterActionReject}},
+	}, false),
+	Entry("ingress rule with a CIDR but no match operator", BGPFilterSpec{
+		IngressRules: []BGPFilterRule{{CIDR: "10.0.0.0/8", Action: BGPFilterActionAccept}},
+	}, true),
+	Entry("egress rule with a CIDR but no match operator", BGPFilterSpec{
+		EgressRules: []BGPFilterRule{
+			{Action: BGPFilterActionAccept},
+			{CIDR: "10.0.0.0/8", Action: BGPFilterActionReject},
+		},
+	}, true),
+)
@@ -15,6 +15,8 @@
 package v3
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8sv1 "k8s.io/api/core/v1"
@@ -115,11 +117,49 @@ type BGPPeerSpec struct {
 	// used, which are 120s for "GracefulRestart" and 3600s for "LongLivedGracefulRestart".
 	MaxRestartTime *metav1.Duration `json:"maxRestartTime,omitempty"`
 
+	// The RFC 4724 graceful restart time, i.e. how long stale routes from the peer are retained
+	// after the session drops while waiting for the peer to restart.  When specified, it takes
+	// precedence over MaxRestartTime for graceful restart.  Must not be negative.
+	// +optional
+	GracefulRestartTime *metav1.Duration `json:"gracefulRestartTime,omitempty" validate:"omitempty"`
+
+	// Enables long-lived graceful restart on the peerings generated by this BGPPeer resource.
+	// Setting this to true is equivalent to setting RestartMode to "LongLivedGracefulRestart";
+	// it must not be combined with RestartMode "GracefulRestart".
+	// +optional
+	LongLivedGracefulRestartEnabled *bool `json:"longLivedGracefulRestartEnabled,omitempty"`
+
+	// The long-lived graceful restart stale time, i.e. how long stale routes are retained once
+	// the graceful restart time has expired.  When specified, it takes precedence over
+	// MaxRestartTime for long-lived graceful restart.  Must not be negative.
+	// +optional
+	LongLivedGracefulRestartTime *metav1.Duration `json:"longLivedGracefulRestartTime,omitempty" validate:"omitempty"`
+
 	// Specifies the BIRD "gateway" mode, i.e. method for computing the immediate next hop for
 	// each received route, for peerings generated by this BGPPeer resource.  Default value
 	// "Recursive" means "gateway recursive".  "DirectIfDirectlyConnected" means to configure
 	// "gateway direct" when the peer is directly connected.
 	BIRDGatewayMode BIRDGatewayMode `json:"birdGatewayMode,omitempty" validate:"omitempty,birdGatewayMode"`
+
+	// The ordered set of BGPFilters applied on this BGP peer.  Each entry is the name of a
+	// BGPFilter resource.
+	// +optional
+	Filters []string `json:"filters,omitempty" validate:"omitempty,dive,name"`
+}
+
+// Validate returns an error if GracefulRestartTime or LongLivedGracefulRestartTime is negative, or if long-lived
+// graceful restart is enabled while RestartMode is "GracefulRestart".
+func (s *BGPPeerSpec) Validate() error {
+	if err := validateNonNegativeDuration("gracefulRestartTime", s.GracefulRestartTime); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("longLivedGracefulRestartTime", s.LongLivedGracefulRestartTime); err != nil {
+		return err
+	}
+	if s.LongLivedGracefulRestartEnabled != nil && *s.LongLivedGracefulRestartEnabled && s.RestartMode == RestartModeGracefulRestart {
+		return fmt.Errorf("longLivedGracefulRestartEnabled must not be true when restartMode is %s", RestartModeGracefulRestart)
+	}
+	return nil
 }
 
 type SourceAddress string
@@ -0,0 +1,59 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("BGPPeerSpec.Validate",
+	func(spec BGPPeerSpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("restart settings unset", BGPPeerSpec{}, false),
+	Entry("zero restart times", BGPPeerSpec{
+		GracefulRestartTime:          &metav1.Duration{},
+		LongLivedGracefulRestartTime: &metav1.Duration{},
+	}, false),
+	Entry("LLGR enabled with positive times", BGPPeerSpec{
+		GracefulRestartTime:             &metav1.Duration{Duration: 120 * time.Second},
+		LongLivedGracefulRestartEnabled: boolPtr(true),
+		LongLivedGracefulRestartTime:    &metav1.Duration{Duration: time.Hour},
+	}, false),
+	Entry("LLGR enabled with the LLGR restart mode", BGPPeerSpec{
+		RestartMode:                     RestartModeLongLivedGracefulRestart,
+		LongLivedGracefulRestartEnabled: boolPtr(true),
+	}, false),
+	Entry("LLGR disabled with the graceful restart mode", BGPPeerSpec{
+		RestartMode:                     RestartModeGracefulRestart,
+		LongLivedGracefulRestartEnabled: boolPtr(false),
+	}, false),
+	Entry("negative graceful restart time", BGPPeerSpec{
+		GracefulRestartTime: &metav1.Duration{Duration: -time.Second},
+	}, true),
+	Entry("negative LLGR time", BGPPeerSpec{
+		LongLivedGracefulRestartTime: &metav1.Duration{Duration: -time.Second},
+	}, true),
+	Entry("LLGR enabled with the graceful restart mode", BGPPeerSpec{
+		RestartMode:                     RestartModeGracefulRestart,
+		LongLivedGracefulRestartEnabled: boolPtr(true),
+	}, true),
+)
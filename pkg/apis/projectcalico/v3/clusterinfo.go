@@ -37,12 +37,14 @@ type ClusterInformationList struct {
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
 
 type ClusterInformation struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec ClusterInformationSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Spec   ClusterInformationSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status ClusterInformationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // ClusterInformationSpec contains the values of describing the cluster.
@@ -60,6 +62,19 @@ type ClusterInformationSpec struct {
 	DatastoreReady *bool `json:"datastoreReady,omitempty"`
 	// Variant declares which variant of Calico should be active.
 	Variant string `json:"variant,omitempty"`
+	// ManagementClusterAddr is the address at which a managed cluster reaches its management cluster.
+	// Only set on managed clusters.
+	ManagementClusterAddr string `json:"managementClusterAddr,omitempty" validate:"omitempty"`
+	// KubernetesVersion is the version of Kubernetes that the cluster is running
+	KubernetesVersion string `json:"kubernetesVersion,omitempty" validate:"omitempty"`
+}
+
+// ClusterInformationStatus contains the observed state of the cluster.
+type ClusterInformationStatus struct {
+	// LastUpdated is the time at which the cluster information was last refreshed.
+	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
+	// OperatorVersion is the version of the operator that manages the cluster's Calico installation.
+	OperatorVersion string `json:"operatorVersion,omitempty"`
 }
 
 // New ClusterInformation creates a new (zeroed) ClusterInformation struct with the TypeMetadata
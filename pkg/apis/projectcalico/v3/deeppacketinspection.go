@@ -11,6 +11,17 @@ const (
 	KindDeepPacketInspectionList = "DeepPacketInspectionList"
 )
 
+// DPIApplicationLayer is an application layer protocol analyzer used by deep packet inspection.
+// +kubebuilder:validation:Enum=HTTP;DNS;TLS;SMB
+type DPIApplicationLayer string
+
+const (
+	DPIApplicationLayerHTTP DPIApplicationLayer = "HTTP"
+	DPIApplicationLayerDNS  DPIApplicationLayer = "DNS"
+	DPIApplicationLayerTLS  DPIApplicationLayer = "TLS"
+	DPIApplicationLayerSMB  DPIApplicationLayer = "SMB"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:subresource:status
@@ -54,6 +65,30 @@ type DeepPacketInspectionSpec struct {
 	// 	deployment != "dev"
 	// 	! has(label_name)
 	Selector string `json:"selector,omitempty" validate:"selector"`
+
+	// The application layer protocol analyzers to enable, any of "HTTP", "DNS", "TLS" and "SMB".
+	// If omitted, all analyzers are enabled.
+	// +optional
+	EnabledApplicationLayers []DPIApplicationLayer `json:"enabledApplicationLayers,omitempty" validate:"omitempty,dive,oneof=HTTP DNS TLS SMB"`
+
+	// The threat feeds that are consulted when inspecting packets.  If omitted, no threat feeds
+	// are consulted.
+	// +optional
+	ThreatFeedRefs []ThreatFeedRef `json:"threatFeedRefs,omitempty" validate:"omitempty,dive"`
+
+	// The maximum number of bytes of each packet that are inspected.  [Default: 8192]
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPacketInspectionDepth *int `json:"maxPacketInspectionDepth,omitempty" validate:"omitempty,gt=0"`
+}
+
+// ThreatFeedRef identifies a threat feed by name and, optionally, namespace.
+type ThreatFeedRef struct {
+	// The name of the threat feed.
+	Name string `json:"name" validate:"name"`
+	// The namespace of the threat feed.  If omitted, the feed is assumed to be a GlobalThreatFeed.
+	// +optional
+	Namespace string `json:"namespace,omitempty" validate:"omitempty,name"`
 }
 
 // DeepPacketInspectionStatus contains status of deep packet inspection in each node.
@@ -0,0 +1,104 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+package v3
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindEgressGateway     = "EgressGateway"
+	KindEgressGatewayList = "EgressGatewayList"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressGateway configures a set of egress gateway pods, including the IP pools that their
+// addresses are allocated from and how the set is scaled.
+type EgressGateway struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the EgressGateway.
+	Spec EgressGatewaySpec `json:"spec,omitempty"`
+}
+
+// EgressGatewaySpec contains the specification for an EgressGateway resource.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not be greater than maxReplicas"
+type EgressGatewaySpec struct {
+	// Selector is an expression used to pick out the egress gateway pods managed by this resource.
+	// The selector will only match pods in the same namespace as the EgressGateway resource.
+	Selector string `json:"selector,omitempty" validate:"selector"`
+
+	// IPPools lists the IP pools that addresses for the egress gateway pods are allocated from.
+	// +optional
+	IPPools []EgressGatewayIPPool `json:"ipPools,omitempty" validate:"omitempty,dive"`
+
+	// MinReplicas is the minimum number of egress gateway pods.  Must not be greater than
+	// MaxReplicas.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" validate:"omitempty,gte=0"`
+
+	// MaxReplicas is the maximum number of egress gateway pods.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" validate:"omitempty,gt=0"`
+
+	// ScaleDownDelay is how long the number of egress gateway pods must have exceeded demand
+	// before it is reduced.
+	// +optional
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty" validate:"omitempty"`
+}
+
+// Validate returns an error if MinReplicas is greater than MaxReplicas.
+func (s *EgressGatewaySpec) Validate() error {
+	if s.MinReplicas != nil && s.MaxReplicas != nil && *s.MinReplicas > *s.MaxReplicas {
+		return fmt.Errorf("minReplicas (%d) must not be greater than maxReplicas (%d)", *s.MinReplicas, *s.MaxReplicas)
+	}
+	return nil
+}
+
+// EgressGatewayIPPool identifies an IP pool used by an egress gateway, by name or by CIDR.
+type EgressGatewayIPPool struct {
+	// Name is the name of the IP pool.
+	// +optional
+	Name string `json:"name,omitempty" validate:"omitempty,name"`
+	// CIDR is the CIDR of the IP pool.
+	// +optional
+	CIDR string `json:"cidr,omitempty" validate:"omitempty,cidr"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressGatewayList contains a list of EgressGateway resources.
+type EgressGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []EgressGateway `json:"items"`
+}
+
+// NewEgressGateway creates a new (zeroed) EgressGateway struct with the TypeMetadata
+// initialized to the current version.
+func NewEgressGateway() *EgressGateway {
+	return &EgressGateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindEgressGateway,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewEgressGatewayList creates a new (zeroed) EgressGatewayList struct with the TypeMetadata
+// initialized to the current version.
+func NewEgressGatewayList() *EgressGatewayList {
+	return &EgressGatewayList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindEgressGatewayList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("EgressGatewaySpec.Validate",
+	func(spec EgressGatewaySpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("replicas unset", EgressGatewaySpec{}, false),
+	Entry("only min replicas set", EgressGatewaySpec{MinReplicas: int32Ptr(3)}, false),
+	Entry("only max replicas set", EgressGatewaySpec{MaxReplicas: int32Ptr(1)}, false),
+	Entry("min replicas below max replicas", EgressGatewaySpec{MinReplicas: int32Ptr(1), MaxReplicas: int32Ptr(3)}, false),
+	Entry("min replicas equal to max replicas", EgressGatewaySpec{MinReplicas: int32Ptr(2), MaxReplicas: int32Ptr(2)}, false),
+	Entry("min replicas above max replicas", EgressGatewaySpec{MinReplicas: int32Ptr(3), MaxReplicas: int32Ptr(1)}, true),
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -15,6 +15,13 @@
 package v3
 
 import (
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/tigera/api/pkg/lib/numorstring"
@@ -60,6 +67,39 @@ const (
 	AWSSrcDstCheckOptionDisable                        = "Disable"
 )
 
+const (
+	DropActionOverrideDrop         = "Drop"
+	DropActionOverrideLogAndDrop   = "LogAndDrop"
+	DropActionOverrideAccept       = "Accept"
+	DropActionOverrideLogAndAccept = "LogAndAccept"
+	DropActionOverrideReject       = "Reject"
+	DropActionOverrideLogAndReject = "LogAndReject"
+)
+
+const (
+	ECMPHashModeLayer3        = "Layer3"
+	ECMPHashModeLayer4        = "Layer4"
+	ECMPHashModeLayer3AndPort = "Layer3AndPort"
+)
+
+const (
+	IPSecPeerCertVerificationCAOnly   = "CAOnly"
+	IPSecPeerCertVerificationPeerName = "PeerName"
+	IPSecPeerCertVerificationPeerSAN  = "PeerSAN"
+)
+
+const (
+	L7LogsFileAggregationUserAgentFullString   = "FullString"
+	L7LogsFileAggregationUserAgentMajorVersion = "MajorVersion"
+	L7LogsFileAggregationUserAgentExclude      = "Exclude"
+)
+
+const (
+	PCAPRemoteStreamProtocolUDP  = "UDP"
+	PCAPRemoteStreamProtocolTCP  = "TCP"
+	PCAPRemoteStreamProtocolGRPC = "GRPC"
+)
+
 // FelixConfigurationSpec contains the values of the Felix configuration.
 type FelixConfigurationSpec struct {
 	UseInternalDataplaneDriver *bool  `json:"useInternalDataplaneDriver,omitempty"`
@@ -74,6 +114,14 @@ type FelixConfigurationSpec struct {
 	// InterfaceRefreshInterval is the period at which Felix rescans local interfaces to verify their state.
 	// The rescan can be disabled by setting the interval to 0.
 	InterfaceRefreshInterval *metav1.Duration `json:"interfaceRefreshInterval,omitempty" configv1timescale:"seconds"`
+	// InterfaceRefreshIntervalOnError is the period at which Felix rescans local interfaces after it fails to read
+	// an interface's state, so that an interface that briefly disappears is picked up again quickly.  Once a rescan
+	// succeeds, Felix falls back to InterfaceRefreshInterval.  [Default: 5s]
+	InterfaceRefreshIntervalOnError *metav1.Duration `json:"interfaceRefreshIntervalOnError,omitempty" configv1timescale:"seconds"`
+	// ArpRefreshInterval is the period at which Felix rescans the ARP table to refresh the entries for local
+	// workload endpoints.  On nodes with many endpoints, a longer interval reduces the cost of the rescans.
+	// Set to 0 to disable ARP refresh. [Default: 60s]
+	ArpRefreshInterval *metav1.Duration `json:"arpRefreshInterval,omitempty" configv1timescale:"seconds"`
 	// IptablesRefreshInterval is the period at which Felix re-checks the IP sets
 	// in the dataplane to ensure that no other process has accidentally broken Calico's rules.
 	// Set to 0 to disable IP sets refresh. Note: the default for this value is lower than the
@@ -105,12 +153,29 @@ type FelixConfigurationSpec struct {
 	// "SNATFullyRandom=true,MASQFullyRandom=false,RestoreSupportsLock=".
 	// "true" or "false" will force the feature, empty or omitted values are
 	// auto-detected.
+	//
+	// Deprecated: Use FeatureDetectOverrides instead.
 	FeatureDetectOverride string `json:"featureDetectOverride,omitempty" validate:"omitempty,keyValueList"`
+	// FeatureDetectOverrides is used to override the feature detection.  Each field that is set forces the
+	// corresponding feature on or off; unset fields are auto-detected.  Takes precedence over FeatureDetectOverride.
+	FeatureDetectOverrides *FeatureDetectOverrides `json:"featureDetectOverrides,omitempty"`
 	// IpsetsRefreshInterval is the period at which Felix re-checks all iptables
 	// state to ensure that no other process has accidentally broken Calico's rules. Set to 0 to
 	// disable iptables refresh. [Default: 90s]
 	IpsetsRefreshInterval *metav1.Duration `json:"ipsetsRefreshInterval,omitempty" configv1timescale:"seconds"`
-	MaxIpsetSize          *int             `json:"maxIpsetSize,omitempty"`
+	// MaxIpsetSize is the maximum number of entries that Felix allows in a single IP set.
+	//
+	// Deprecated: use MaxIpsetSoftLimit and MaxIpsetHardLimit instead.
+	MaxIpsetSize *int `json:"maxIpsetSize,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// MaxIpsetSoftLimit is the number of entries in an IP set at which Felix starts to aggregate entries, applying
+	// increasing levels of aggregation as the IP set approaches the limit. Must not be greater than
+	// MaxIpsetHardLimit. [Default: unset]
+	MaxIpsetSoftLimit *int `json:"maxIpsetSoftLimit,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// MaxIpsetHardLimit is the maximum number of entries in an IP set.  Once it is reached, Felix refuses to add
+	// further entries and reports an error. [Default: unset]
+	MaxIpsetHardLimit *int `json:"maxIpsetHardLimit,omitempty" validate:"omitempty,gt=0"`
 	// IptablesBackend specifies which backend of iptables will be used. The default is legacy.
 	IptablesBackend *IptablesBackend `json:"iptablesBackend,omitempty" validate:"omitempty,iptablesBackend"`
 
@@ -119,7 +184,18 @@ type FelixConfigurationSpec struct {
 	// disable XDP refresh. [Default: 90s]
 	XDPRefreshInterval *metav1.Duration `json:"xdpRefreshInterval,omitempty" configv1timescale:"seconds"`
 
+	// NetlinkTimeout is the timeout for netlink operations.  If NetlinkGetTimeout or NetlinkSetTimeout is unset, it
+	// defaults to the value of this field.
+	//
+	// Deprecated: set NetlinkGetTimeout and NetlinkSetTimeout instead.
 	NetlinkTimeout *metav1.Duration `json:"netlinkTimeout,omitempty" configv1timescale:"seconds" confignamev1:"NetlinkTimeoutSecs"`
+	// NetlinkGetTimeout is the timeout for netlink operations that read dataplane state.  Reads are usually quicker
+	// than writes, but a long timeout can avoid false alarms on a slow node.  [Default: NetlinkTimeout if set,
+	// otherwise 10s]
+	NetlinkGetTimeout *metav1.Duration `json:"netlinkGetTimeout,omitempty" configv1timescale:"seconds"`
+	// NetlinkSetTimeout is the timeout for netlink operations that write dataplane state.  Keeping it shorter than
+	// NetlinkGetTimeout avoids masking genuine write failures.  [Default: NetlinkTimeout if set, otherwise 10s]
+	NetlinkSetTimeout *metav1.Duration `json:"netlinkSetTimeout,omitempty" configv1timescale:"seconds"`
 
 	// MetadataAddr is the IP address or domain name of the server that can answer VM queries for
 	// cloud-init metadata. In OpenStack, this corresponds to the machine running nova-api (or in
@@ -141,7 +217,13 @@ type FelixConfigurationSpec struct {
 	// them from host endpoint interfaces. Note: in environments other than bare metal, the orchestrators
 	// configure this appropriately. For example our Kubernetes and Docker integrations set the 'cali' value,
 	// and our OpenStack integration sets the 'tap' value. [Default: cali]
+	//
+	// Deprecated: use InterfacePrefixes instead. InterfacePrefix is ignored when InterfacePrefixes is set.
 	InterfacePrefix string `json:"interfacePrefix,omitempty"`
+	// InterfacePrefixes is the list of interface name prefixes that identify workload endpoints and so distinguish
+	// them from host endpoint interfaces. Multiple prefixes are needed when more than one CNI plugin creates workload
+	// interfaces on the same host. When set, this takes precedence over InterfacePrefix. [Default: unset]
+	InterfacePrefixes *[]string `json:"interfacePrefixes,omitempty" validate:"omitempty,dive,gt=0"`
 	// InterfaceExclude is a comma-separated list of interfaces that Felix should exclude when monitoring for host
 	// endpoints. The default value ensures that Felix ignores Kubernetes' IPVS dummy interface, which is used
 	// internally by kube-proxy. If you want to exclude multiple interface names using a single value, the list
@@ -166,6 +248,11 @@ type FelixConfigurationSpec struct {
 	DefaultEndpointToHostAction string `json:"defaultEndpointToHostAction,omitempty" validate:"omitempty,dropAcceptReturn"`
 	IptablesFilterAllowAction   string `json:"iptablesFilterAllowAction,omitempty" validate:"omitempty,acceptReturn"`
 	IptablesMangleAllowAction   string `json:"iptablesMangleAllowAction,omitempty" validate:"omitempty,acceptReturn"`
+	// +kubebuilder:validation:Enum=Drop;Reject
+	// IptablesFilterDenyAction controls the iptables action that Felix uses for traffic that is denied by policy.
+	// "Drop" silently discards the packet, which makes the host harder to fingerprint, whereas "Reject" answers with
+	// a TCP reset or ICMP unreachable so that connections fail fast, which is easier to debug. [Default: Drop]
+	IptablesFilterDenyAction string `json:"iptablesFilterDenyAction,omitempty" validate:"omitempty,oneof=Drop Reject"`
 	// LogPrefix is the log prefix that Felix uses when rendering LOG rules. [Default: calico-packet]
 	LogPrefix string `json:"logPrefix,omitempty"`
 
@@ -186,12 +273,30 @@ type FelixConfigurationSpec struct {
 	IPIPEnabled *bool `json:"ipipEnabled,omitempty" confignamev1:"IpInIpEnabled"`
 	// IPIPMTU is the MTU to set on the tunnel device. See Configuring MTU [Default: 1440]
 	IPIPMTU *int `json:"ipipMTU,omitempty" confignamev1:"IpInIpMtu"`
+	// +kubebuilder:validation:Minimum=1280
+	// IPIPMTUIPv6 is the MTU to set on the IPv6 IPIP tunnel device.  It should be the link MTU minus the IPv6
+	// encapsulation overhead of 40 bytes, and must be at least 1280, the minimum MTU for IPv6.
+	// [Default: unset - derived from the link MTU]
+	IPIPMTUIPv6 *int `json:"ipipMTUIPv6,omitempty" validate:"omitempty,gte=1280"`
+	// IPIPTunnelAddress, if set, is the IPv4 address that Felix assigns to the IPIP tunnel device instead of one
+	// allocated by Calico IPAM.  This is advanced usage: the address is not tracked by IPAM, so it may conflict with
+	// addresses that IPAM assigns to other nodes or workloads.  [Default: unset - allocated by IPAM]
+	IPIPTunnelAddress string `json:"ipipTunnelAddress,omitempty" validate:"omitempty,ipv4"`
 
 	VXLANEnabled *bool `json:"vxlanEnabled,omitempty"`
 	// VXLANMTU is the MTU to set on the tunnel device. See Configuring MTU [Default: 1440]
 	VXLANMTU  *int `json:"vxlanMTU,omitempty"`
 	VXLANPort *int `json:"vxlanPort,omitempty"`
 	VXLANVNI  *int `json:"vxlanVNI,omitempty"`
+	// +kubebuilder:validation:Minimum=1280
+	// VXLANMTUIPv6 is the MTU to set on the IPv6 VXLAN tunnel device.  It should be the link MTU minus the VXLAN
+	// over IPv6 encapsulation overhead of 70 bytes, and must be at least 1280, the minimum MTU for IPv6.
+	// [Default: unset - derived from the link MTU]
+	VXLANMTUIPv6 *int `json:"vxlanMTUIPv6,omitempty" validate:"omitempty,gte=1280"`
+	// VXLANTunnelAddress, if set, is the IPv4 address that Felix assigns to the VXLAN tunnel device instead of one
+	// allocated by Calico IPAM.  This is advanced usage: the address is not tracked by IPAM, so it may conflict with
+	// addresses that IPAM assigns to other nodes or workloads.  [Default: unset - allocated by IPAM]
+	VXLANTunnelAddress string `json:"vxlanTunnelAddress,omitempty" validate:"omitempty,ipv4"`
 
 	// AllowVXLANPacketsFromWorkloads controls whether Felix will add a rule to drop VXLAN encapsulated traffic
 	// from workloads [Default: false]
@@ -207,6 +312,13 @@ type FelixConfigurationSpec struct {
 	ReportingInterval *metav1.Duration `json:"reportingInterval,omitempty" configv1timescale:"seconds" confignamev1:"ReportingIntervalSecs"`
 	// ReportingTTL is the time-to-live setting for process-wide status reports. [Default: 90s]
 	ReportingTTL *metav1.Duration `json:"reportingTTL,omitempty" configv1timescale:"seconds" confignamev1:"ReportingTTLSecs"`
+	// +kubebuilder:validation:Enum=CRDStatus;ConfigMap;DisableReporting
+	// ReportingMechanism controls how Felix reports its status.  "CRDStatus" writes status to the datastore,
+	// which requires Felix to have write access to Calico's status resources.  "ConfigMap" writes status to a
+	// node-specific ConfigMap instead, which only requires write access to ConfigMaps and so suits restricted
+	// RBAC profiles.  "DisableReporting" turns status reporting off, so Felix needs neither permission.
+	// [Default: CRDStatus]
+	ReportingMechanism string `json:"reportingMechanism,omitempty" validate:"omitempty,oneof=CRDStatus ConfigMap DisableReporting"`
 
 	EndpointReportingEnabled *bool            `json:"endpointReportingEnabled,omitempty"`
 	EndpointReportingDelay   *metav1.Duration `json:"endpointReportingDelay,omitempty" configv1timescale:"seconds" confignamev1:"EndpointReportingDelaySecs"`
@@ -221,6 +333,14 @@ type FelixConfigurationSpec struct {
 	HealthEnabled *bool   `json:"healthEnabled,omitempty"`
 	HealthHost    *string `json:"healthHost,omitempty"`
 	HealthPort    *int    `json:"healthPort,omitempty"`
+	// HealthCheckTimeout is the write timeout for responses from Felix's health endpoint, which stops a stuck
+	// health check from hanging the caller's probe. Set to 0 to disable the timeout; must not be negative.
+	// [Default: 10s]
+	HealthCheckTimeout *metav1.Duration `json:"healthCheckTimeout,omitempty" configv1timescale:"seconds"`
+	// HealthTimeoutOverrides allows the internal health timeouts of individual Felix components to be overridden.
+	// The map is keyed on the component name, for example "dataplane", "policySync" or "ipsetsSyncer", and each
+	// value must be a positive duration. [Default: unset - each component uses its built-in timeout]
+	HealthTimeoutOverrides map[string]*metav1.Duration `json:"healthTimeoutOverrides,omitempty" validate:"omitempty,dive,keys,gt=0,endkeys,required"`
 
 	// PrometheusMetricsEnabled enables the Prometheus metrics server in Felix if set to true. [Default: false]
 	PrometheusMetricsEnabled *bool `json:"prometheusMetricsEnabled,omitempty"`
@@ -241,6 +361,14 @@ type FelixConfigurationSpec struct {
 	PrometheusMetricsCertFile string `json:"prometheusMetricsCertFile,omitempty"`
 	PrometheusMetricsKeyFile  string `json:"prometheusMetricsKeyFile,omitempty"`
 	PrometheusMetricsCAFile   string `json:"prometheusMetricsCAFile,omitempty"`
+	// +kubebuilder:validation:Enum=TLSv10;TLSv11;TLSv12;TLSv13
+	// PrometheusMetricsTLSMinVersion is the minimum TLS version accepted by the Prometheus metrics server when TLS
+	// credentials are configured. [Default: TLSv12]
+	PrometheusMetricsTLSMinVersion string `json:"prometheusMetricsTLSMinVersion,omitempty" validate:"omitempty,oneof=TLSv10 TLSv11 TLSv12 TLSv13"`
+	// PrometheusMetricsTLSCipherSuites restricts the cipher suites accepted by the Prometheus metrics server when TLS
+	// credentials are configured. Each entry is a Go TLS cipher suite name, for example
+	// "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384". [Default: unset - Go's default cipher suites]
+	PrometheusMetricsTLSCipherSuites *[]string `json:"prometheusMetricsTLSCipherSuites,omitempty" validate:"omitempty,dive,tlsCipherSuite"`
 
 	// FailsafeInboundHostPorts is a list of UDP/TCP ports and CIDRs that Felix will allow incoming traffic to host endpoints
 	// on irrespective of the security policy. This is useful to avoid accidentally cutting off a host with incorrect configuration.
@@ -248,14 +376,14 @@ type FelixConfigurationSpec struct {
 	// traffic from all addresses. To disable all inbound host ports, use the value none. The default value allows ssh access
 	// and DHCP.
 	// [Default: tcp:22, udp:68, tcp:179, tcp:2379, tcp:2380, tcp:6443, tcp:6666, tcp:6667]
-	FailsafeInboundHostPorts *[]ProtoPort `json:"failsafeInboundHostPorts,omitempty"`
+	FailsafeInboundHostPorts *[]ProtoPort `json:"failsafeInboundHostPorts,omitempty" validate:"omitempty,dive,protoPort"`
 	// FailsafeOutboundHostPorts is a list of UDP/TCP ports and CIDRs that Felix will allow outgoing traffic from host endpoints
 	// to irrespective of the security policy. This is useful to avoid accidentally cutting off a host with incorrect configuration.
 	// For back-compatibility, if the protocol is not specified, it defaults to "tcp". If a CIDR is not specified, it will allow
 	// traffic from all addresses. To disable all outbound host ports, use the value none. The default value opens etcd's standard
 	// ports to ensure that Felix does not get cut off from etcd as well as allowing DHCP and DNS.
 	// [Default: tcp:179, tcp:2379, tcp:2380, tcp:6443, tcp:6666, tcp:6667, udp:53, udp:67]
-	FailsafeOutboundHostPorts *[]ProtoPort `json:"failsafeOutboundHostPorts,omitempty"`
+	FailsafeOutboundHostPorts *[]ProtoPort `json:"failsafeOutboundHostPorts,omitempty" validate:"omitempty,dive,protoPort"`
 
 	// KubeMasqueradeBit should be set to the same value as --iptables-masquerade-bit of kube-proxy
 	// when TPROXY is used. The default is the same as kube-proxy default thus only needs a change
@@ -299,6 +427,14 @@ type FelixConfigurationSpec struct {
 	// applications to also add device routes. This is enabled by default which means we will remove externally added routes.
 	RemoveExternalRoutes *bool `json:"removeExternalRoutes,omitempty"`
 
+	// ECMPEnabled controls whether Felix programs equal-cost multipath routes, with multiple next hops, instead
+	// of a single next hop per route. [Default: false]
+	ECMPEnabled *bool `json:"ecmpEnabled,omitempty"`
+	// +kubebuilder:validation:Enum=Layer3;Layer4;Layer3AndPort
+	// ECMPHashMode selects how the kernel hashes packets across the next hops of an equal-cost multipath route.
+	// One of "Layer3", "Layer4" or "Layer3AndPort".  May only be set when ECMPEnabled is true. [Default: Layer3]
+	ECMPHashMode string `json:"ecmpHashMode,omitempty" validate:"omitempty,oneof=Layer3 Layer4 Layer3AndPort"`
+
 	// ExternalNodesCIDRList is a list of CIDR's of external-non-calico-nodes which may source tunnel traffic and have
 	// the tunneled traffic be accepted at calico nodes.
 	ExternalNodesCIDRList *[]string `json:"externalNodesList,omitempty"`
@@ -314,8 +450,10 @@ type FelixConfigurationSpec struct {
 	PrometheusReporterCAFile    string `json:"prometheusReporterCAFile,omitempty"`
 	DeletedMetricsRetentionSecs *int   `json:"deletedMetricsRetentionSecs,omitempty"`
 
-	// DropActionOverride overrides the Drop action in Felix, optionally changing the behavior to Accept, and optionally adding Log.
-	// Possible values are Drop, LogAndDrop, Accept, LogAndAccept. [Default: Drop]
+	// +kubebuilder:validation:Enum=Drop;LogAndDrop;Accept;LogAndAccept;Reject;LogAndReject
+	// DropActionOverride overrides the Drop action in Felix, optionally changing the behavior to Accept or Reject, and
+	// optionally adding Log. Reject sends an ICMP unreachable message back to the sender instead of silently dropping
+	// the packet. Possible values are Drop, LogAndDrop, Accept, LogAndAccept, Reject, LogAndReject. [Default: Drop]
 	DropActionOverride string `json:"dropActionOverride,omitempty" validate:"omitempty,dropActionOverride"`
 
 	DebugMemoryProfilePath          string           `json:"debugMemoryProfilePath,omitempty"`
@@ -323,6 +461,17 @@ type FelixConfigurationSpec struct {
 	DebugSimulateCalcGraphHangAfter *metav1.Duration `json:"debugSimulateCalcGraphHangAfter,omitempty" configv1timescale:"seconds"`
 	DebugSimulateDataplaneHangAfter *metav1.Duration `json:"debugSimulateDataplaneHangAfter,omitempty" configv1timescale:"seconds"`
 
+	// DebugCPUProfilePath is the path to which Felix writes pprof CPU profiles, which lets a CPU profile be captured
+	// without restarting Felix.  This is a debug setting that should never be set in production.  [Default: unset -
+	// CPU profiling disabled]
+	DebugCPUProfilePath string `json:"debugCPUProfilePath,omitempty"`
+	// DebugCPUProfileInterval is how long each CPU profile written to DebugCPUProfilePath samples for.  This is a
+	// debug setting that should never be set in production.  [Default: 30s]
+	DebugCPUProfileInterval *metav1.Duration `json:"debugCPUProfileInterval,omitempty" configv1timescale:"seconds"`
+	// DebugPanicOnWriteFailure causes Felix to panic, rather than retry, when a write to the dataplane fails.  This
+	// is a debug setting that should never be set in production.  [Default: false]
+	DebugPanicOnWriteFailure *bool `json:"debugPanicOnWriteFailure,omitempty"`
+
 	IptablesNATOutgoingInterfaceFilter string `json:"iptablesNATOutgoingInterfaceFilter,omitempty" validate:"omitempty,ifaceFilter"`
 
 	// SidecarAccelerationEnabled enables experimental sidecar acceleration [Default: false]
@@ -346,6 +495,24 @@ type FelixConfigurationSpec struct {
 	// "Debug".  The logs are emitted to the BPF trace pipe, accessible with the command `tc exec bpf debug`.
 	// [Default: Off].
 	BPFLogLevel string `json:"bpfLogLevel,omitempty" validate:"omitempty,bpfLogLevel"`
+	// BPFLogFilters, in BPF dataplane mode, sets the log level for individual BPF programs.  Each key is the name
+	// of a BPF program, for example "cali_from_wep", and each value is one of "Off", "Info" or "Debug".  Programs
+	// listed here use the given log level instead of BPFLogLevel.  [Default: unset]
+	BPFLogFilters *map[string]string `json:"bpfLogFilters,omitempty" validate:"omitempty,dive,keys,bpfProgramName,endkeys,oneof=Off Info Debug"`
+	// BPFInterfaceLogFilters, in BPF dataplane mode, sets the log level of the BPF programs attached to particular
+	// interfaces.  Each key is a regular expression that is matched against interface names and each value is one
+	// of "Off", "Info" or "Debug".  Where more than one pattern matches an interface, the longest pattern wins.
+	// Interfaces that match no pattern use BPFLogLevel, and a program listed in BPFLogFilters uses that level
+	// whatever its interface.  [Default: unset]
+	BPFInterfaceLogFilters *map[string]string `json:"bpfInterfaceLogFilters,omitempty" validate:"omitempty,dive,keys,regexp,endkeys,oneof=Off Info Debug"`
+	// BPFPolicyDebugEnabled when true, Felix emits trace events for BPF policy decisions.  When BPFLogLevel is "Off",
+	// only the policy decision events are emitted, which makes it possible to debug policy drops without flooding
+	// the kernel trace pipe.  [Default: false]
+	BPFPolicyDebugEnabled *bool `json:"bpfPolicyDebugEnabled,omitempty" validate:"omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFPolicyDebugSamplingRate controls the fraction of packets for which policy decision trace events are
+	// emitted when BPFPolicyDebugEnabled is true: one in every N packets is traced.  [Default: 1]
+	BPFPolicyDebugSamplingRate *int `json:"bpfPolicyDebugSamplingRate,omitempty" validate:"omitempty,gte=1"`
 	// BPFDataIfacePattern is a regular expression that controls which interfaces Felix should attach BPF programs to
 	// in order to catch traffic to/from the network.  This needs to match the interfaces that Calico workload traffic
 	// flows over as well as any interfaces that handle incoming traffic to nodeports and services from outside the
@@ -356,12 +523,41 @@ type FelixConfigurationSpec struct {
 	// and it improves the performance of pod-to-service connections.  The only reason to disable it is for debugging
 	// purposes.  [Default: true]
 	BPFConnectTimeLoadBalancingEnabled *bool `json:"bpfConnectTimeLoadBalancingEnabled,omitempty" validate:"omitempty"`
+	// BPFCTLBHeartbeatEnabled in BPF mode, controls whether the connect-time load balancer checks the health of
+	// service endpoints with periodic heartbeats and skips endpoints that have not responded within
+	// BPFCTLBHeartbeatInterval, rather than relying only on endpoint slice updates.  Has no effect unless
+	// BPFConnectTimeLoadBalancingEnabled is true.  [Default: false]
+	BPFCTLBHeartbeatEnabled *bool `json:"bpfCTLBHeartbeatEnabled,omitempty" validate:"omitempty"`
+	// BPFCTLBHeartbeatInterval in BPF mode, is the interval between connect-time load balancer heartbeats and the
+	// time within which an endpoint must respond to remain eligible.  Only used when BPFCTLBHeartbeatEnabled is
+	// true.  [Default: 10s]
+	BPFCTLBHeartbeatInterval *metav1.Duration `json:"bpfCTLBHeartbeatInterval,omitempty" configv1timescale:"seconds"`
+	// BPFHostConntrackBypass controls whether traffic of host-networked workloads bypasses Linux conntrack in BPF
+	// mode, which reduces the per-packet overhead for high-throughput workloads.  Only takes effect when BPFEnabled
+	// is true.  Warning: changing this setting may affect the correctness of policy enforcement for host-networked
+	// workloads and should only be done in trusted environments.  [Default: true]
+	BPFHostConntrackBypass *bool `json:"bpfHostConntrackBypass,omitempty" validate:"omitempty"`
+	// +kubebuilder:validation:Enum=BPFOnly;NFConntrack;Auto
+	// BPFConntrackMode in BPF mode, controls which connection tracking implementation Felix uses.  "BPFOnly" uses the
+	// BPF conntrack tables, "NFConntrack" falls back to Linux netfilter conntrack, which works around kernels whose
+	// BPF conntrack support is incomplete, and "Auto" probes the kernel and picks the best option.  Has no effect
+	// when BPFEnabled is false.  [Default: Auto]
+	BPFConntrackMode string `json:"bpfConntrackMode,omitempty" validate:"omitempty,oneof=BPFOnly NFConntrack Auto"`
 	// BPFExternalServiceMode in BPF mode, controls how connections from outside the cluster to services (node ports
 	// and cluster IPs) are forwarded to remote workloads.  If set to "Tunnel" then both request and response traffic
 	// is tunneled to the remote node.  If set to "DSR", the request traffic is tunneled but the response traffic
 	// is sent directly from the remote node.  In "DSR" mode, the remote node appears to use the IP of the ingress
 	// node; this requires a permissive L2 network.  [Default: Tunnel]
 	BPFExternalServiceMode string `json:"bpfExternalServiceMode,omitempty" validate:"omitempty,bpfServiceMode"`
+	// BPFExternalServiceModeNodePort in BPF mode, overrides BPFExternalServiceMode for traffic to node ports.  Node
+	// port traffic may return via a different NIC than the one it arrived on, so it can need a different mode from
+	// cluster IP traffic.  Accepts the same values as BPFExternalServiceMode.  [Default: unset - use BPFExternalServiceMode]
+	BPFExternalServiceModeNodePort string `json:"bpfExternalServiceModeNodePort,omitempty" validate:"omitempty,bpfServiceMode"`
+	// BPFExcludeCIDRsFromNAT in BPF mode, is a list of CIDRs whose traffic bypasses the BPF NAT tables, for example,
+	// load balancer VIPs that are announced by MetalLB or reached through direct routing.  Traffic to these CIDRs is
+	// not NATted to a service backend, so BPFExternalServiceMode and BPFExternalServiceModeNodePort have no effect
+	// on it.  [Default: unset]
+	BPFExcludeCIDRsFromNAT *[]string `json:"bpfExcludeCIDRsFromNAT,omitempty" validate:"omitempty,dive,cidr"`
 	// BPFExtToServiceConnmark in BPF mode, control a 32bit mark that is set on connections from an
 	// external client to a local service. This mark allows us to control how packets of that
 	// connection are routed within the host and how is routing intepreted by RPF check. [Default: 0]
@@ -376,6 +572,48 @@ type FelixConfigurationSpec struct {
 	// BPFKubeProxyEndpointSlicesEnabled in BPF mode, controls whether Felix's
 	// embedded kube-proxy accepts EndpointSlices or not.
 	BPFKubeProxyEndpointSlicesEnabled *bool `json:"bpfKubeProxyEndpointSlicesEnabled,omitempty" validate:"omitempty"`
+	// BPFKubeProxyEndpointHealthzPort in BPF mode, is the port on which Felix serves a health endpoint for its
+	// embedded kube-proxy.  The endpoint reports healthy while the last kube-proxy sync succeeded within twice
+	// BPFKubeProxyMinSyncPeriod.  Must not be the same as HealthPort or the Prometheus ports.  [Default: unset - no
+	// health endpoint]
+	BPFKubeProxyEndpointHealthzPort *int `json:"bpfKubeProxyEndpointHealthzPort,omitempty" validate:"omitempty,gt=0,lte=65535"`
+	// BPFKubeProxyNodePortRanges in BPF mode, holds the list of port ranges that Felix's embedded kube-proxy uses for
+	// service node ports.  When set, it takes precedence over KubeNodePortRanges in BPF mode.
+	// [Default: unset - use KubeNodePortRanges]
+	BPFKubeProxyNodePortRanges *[]numorstring.Port `json:"bpfKubeProxyNodePortRanges,omitempty" validate:"omitempty,dive"`
+	// BPFForceTrackPacketsFromIfaces in BPF mode, forces traffic from these interfaces to go through conntrack,
+	// even where the BPF dataplane would otherwise bypass it.  Each entry is an interface name, which may include
+	// a "*" wildcard, for example "eth0" or "bond*".  [Default: unset]
+	BPFForceTrackPacketsFromIfaces *[]string `json:"bpfForceTrackPacketsFromIfaces,omitempty" validate:"omitempty,dive,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFMapSizeConntrack sets the size for the conntrack map.  This map must be large enough to hold
+	// an entry for each active connection.  Warning: changing the size of the conntrack map can cause disruption.
+	// [Default: 512000]
+	BPFMapSizeConntrack *int `json:"bpfMapSizeConntrack,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFMapSizeNATFrontend sets the size for the nat front end map.
+	// FrontendMap should be large enough to hold an entry for each nodeport,
+	// external IP and each port in each service.  [Default: 65536]
+	BPFMapSizeNATFrontend *int `json:"bpfMapSizeNATFrontend,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFMapSizeNATBackend sets the size for nat back end map.
+	// This is the total number of endpoints. This is mostly
+	// more than the size of the number of services.  [Default: 262144]
+	BPFMapSizeNATBackend *int `json:"bpfMapSizeNATBackend,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFMapSizeNATAffinity sets the size of the map used to track service affinity, i.e. the backend that each
+	// client was last sent to for services with session affinity.  [Default: 65536]
+	BPFMapSizeNATAffinity *int `json:"bpfMapSizeNATAffinity,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFMapSizeRoute sets the size for the routes map.  The routes map should be large enough
+	// to hold one entry per workload and a handful of entries per host (enough to cover its own IPs and
+	// tunnel IPs).  [Default: 262144]
+	BPFMapSizeRoute *int `json:"bpfMapSizeRoute,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// BPFCTMaxTrackedConnections in BPF mode, limits the number of connections that Felix tracks in the BPF conntrack
+	// table.  Once the limit is reached, Felix reports a BPF_CT_OVERFLOW warning metric and new connections are
+	// rejected.  This provides back-pressure before the kernel conntrack map itself is full.  [Default: unset - no limit]
+	BPFCTMaxTrackedConnections *int `json:"bpfCTMaxTrackedConnections,omitempty" validate:"omitempty,gt=0"`
 
 	SyslogReporterNetwork string `json:"syslogReporterNetwork,omitempty"`
 	SyslogReporterAddress string `json:"syslogReporterAddress,omitempty"`
@@ -386,6 +624,11 @@ type FelixConfigurationSpec struct {
 	// IPSecAllowUnsecuredTraffic controls whether non-IPsec traffic is allowed in addition to IPsec traffic. Enabling this
 	// negates the anti-spoofing protections of IPsec but it is useful when migrating to/from IPsec. [Default: false]
 	IPSecAllowUnsecuredTraffic *bool `json:"ipsecAllowUnsecuredTraffic,omitempty"`
+	// IPSecStrictMode, when enabled, makes Felix install an XFRM policy that drops any traffic arriving on an
+	// IPSec-configured interface without a matching security association, in addition to the standard allow rules.
+	// Requires IPSecMode to be set. Enabling this on a running cluster drops traffic between nodes that have not yet
+	// established IPSec tunnels, so it should only be enabled once IPSec is up on all nodes. [Default: false]
+	IPSecStrictMode *bool `json:"ipsecStrictMode,omitempty"`
 	// IPSecIKEAlgorithm sets IPSec IKE algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-prfsha256-ecp256]
 	IPSecIKEAlgorithm string `json:"ipsecIKEAlgorithm,omitempty"`
 	// IPSecESAlgorithm sets IPSec ESP algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-ecp256]
@@ -397,13 +640,49 @@ type FelixConfigurationSpec struct {
 	// IPSecPolicyRefreshInterval is the interval at which Felix will check the kernel's IPsec policy tables and
 	// repair any inconsistencies. [Default: 600s]
 	IPSecPolicyRefreshInterval *metav1.Duration `json:"ipsecPolicyRefreshInterval,omitempty" configv1timescale:"seconds"`
+	// +kubebuilder:validation:Enum=CAOnly;PeerName;PeerSAN
+	// IPSecPeerCertVerification controls how Felix verifies the certificates presented by IPSec peers.
+	// CAOnly accepts any certificate signed by the cluster CA. PeerName additionally requires the certificate CN
+	// to match the peer's node name. PeerSAN additionally requires a Subject Alternative Name of the certificate to
+	// match the peer. [Default: CAOnly]
+	IPSecPeerCertVerification string `json:"ipsecPeerCertVerification,omitempty" validate:"omitempty,oneof=CAOnly PeerName PeerSAN"`
+	// +kubebuilder:validation:Minimum=32
+	// +kubebuilder:validation:Maximum=4096
+	// IPSecReplayWindowSize sets the size of the IPSec anti-replay window, in packets. Larger windows avoid spurious
+	// drops on high-latency links where packets are reordered. [Default: 32]
+	IPSecReplayWindowSize *int `json:"ipsecReplayWindowSize,omitempty" validate:"omitempty,gte=32,lte=4096"`
+	// IPSecDPDTimeout is the time after which an IPSec peer that has not responded to dead peer detection is
+	// considered dead. [Default: 30s]
+	IPSecDPDTimeout *metav1.Duration `json:"ipsecDPDTimeout,omitempty" configv1timescale:"seconds"`
+	// +kubebuilder:validation:Enum=clear;hold;restart
+	// IPSecDPDAction controls the action taken when dead peer detection detects that an IPSec peer is dead.
+	// Accepted values are "clear", "hold" and "restart". [Default: clear]
+	IPSecDPDAction string `json:"ipsecDPDAction,omitempty" validate:"omitempty,oneof=clear hold restart"`
 
 	// FlowLogsFlushInterval configures the interval at which Felix exports flow logs.
 	FlowLogsFlushInterval *metav1.Duration `json:"flowLogsFlushInterval,omitempty" configv1timescale:"seconds"`
+	// +kubebuilder:validation:Minimum=1
+	// FlowLogsMaxBytesPerFlush limits the number of buffered flow log bytes that Felix writes in a single flush.
+	// When the buffered logs reach this size before FlowLogsFlushInterval has elapsed, Felix flushes early.
+	// [Default: unset, meaning no limit]
+	FlowLogsMaxBytesPerFlush *int `json:"flowLogsMaxBytesPerFlush,omitempty" validate:"omitempty,gt=0"`
+	// FlowLogsExportBatchTimeout is the maximum time that Felix holds a partial batch of flow logs before exporting it
+	// to an external sink, even if the batch has not reached its size threshold. Set to 0 to export each flow log
+	// immediately, without batching. [Default: 5s]
+	FlowLogsExportBatchTimeout *metav1.Duration `json:"flowLogsExportBatchTimeout,omitempty" configv1timescale:"seconds"`
 	// FlowLogsEnableHostEndpoint enables Flow logs reporting for HostEndpoints.
 	FlowLogsEnableHostEndpoint *bool `json:"flowLogsEnableHostEndpoint,omitempty"`
 	// FlowLogsEnableNetworkSets enables Flow logs reporting for GlobalNetworkSets.
 	FlowLogsEnableNetworkSets *bool `json:"flowLogsEnableNetworkSets,omitempty"`
+	// FlowLogsDNSAggregationEnabled controls whether DNS names are included in the aggregation keys of Flow log
+	// entries. Disabling this reduces the number of distinct Flow log entries when DNS answers change frequently.
+	// When FlowLogsEnableNetworkSets is enabled, endpoints that match a network set are still identified by the
+	// network set, whatever the value of this setting. [Default: true]
+	FlowLogsDNSAggregationEnabled *bool `json:"flowLogsDNSAggregationEnabled,omitempty"`
+	// FlowLogsEnableDNSPolicyViolation enables Flow logs reporting for connections that are denied by DNS policy,
+	// attributed to the policy rule that denied them. Disabling this suppresses those entries, which can be useful
+	// when their volume is very high. [Default: true]
+	FlowLogsEnableDNSPolicyViolation *bool `json:"flowLogsEnableDNSPolicyViolation,omitempty"`
 	// FlowLogsMaxOriginalIPsIncluded specifies the number of unique IP addresses (if relevant) that should be included in Flow logs.
 	FlowLogsMaxOriginalIPsIncluded *int `json:"flowLogsMaxOriginalIPsIncluded,omitempty"`
 	// FlowLogsCollectProcessInfo, if enabled Felix will load the kprobe BPF programs to collect process info. [Default: false]
@@ -425,31 +704,54 @@ type FelixConfigurationSpec struct {
 	FlowLogsFileMaxFiles *int `json:"flowLogsFileMaxFiles,omitempty"`
 	// FlowLogsFileMaxFileSizeMB sets the max size in MB of flow logs files before rotation.
 	FlowLogsFileMaxFileSizeMB *int `json:"flowLogsFileMaxFileSizeMB,omitempty"`
+	// FlowLogsFileCompressionEnabled when set to true, compresses flow log files with gzip after they are rotated.
+	// The active log file is never compressed. [Default: false]
+	FlowLogsFileCompressionEnabled *bool `json:"flowLogsFileCompressionEnabled,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	// FlowLogsFileCompressionLevel sets the gzip compression level used for flow log files, from 1 (fastest) to
+	// 9 (best compression). This is ignored unless FlowLogsFileCompressionEnabled is true. [Default: 6]
+	FlowLogsFileCompressionLevel *int `json:"flowLogsFileCompressionLevel,omitempty" validate:"omitempty,gte=1,lte=9"`
 	// FlowLogsFileDirectory sets the directory where flow logs files are stored.
 	FlowLogsFileDirectory *string `json:"flowLogsFileDirectory,omitempty"`
 	// FlowLogsFileIncludeLabels is used to configure if endpoint labels are included in a Flow log entry written to file.
 	FlowLogsFileIncludeLabels *bool `json:"flowLogsFileIncludeLabels,omitempty"`
 	// FlowLogsFileIncludePolicies is used to configure if policy information are included in a Flow log entry written to file.
 	FlowLogsFileIncludePolicies *bool `json:"flowLogsFileIncludePolicies,omitempty"`
+	// FlowLogsPolicyFields selects which parts of the policy information are included in a Flow log entry written
+	// to file. Accepted values are tier_name, policy_name, rule_action, rule_index and namespace. When unset, all
+	// policy information is included. FlowLogsFileIncludePolicies must be enabled for this field to take effect.
+	FlowLogsPolicyFields *[]string `json:"flowLogsPolicyFields,omitempty" validate:"omitempty,dive,oneof=tier_name policy_name rule_action rule_index namespace"`
 	// FlowLogsFileIncludeService is used to configure if the destination service is included in a Flow log entry written to file.
 	// The service information can only be included if the flow was explicitly determined to be directed at the service (e.g.
 	// when the pre-DNAT destination corresponds to the service ClusterIP and port).
 	FlowLogsFileIncludeService *bool `json:"flowLogsFileIncludeService,omitempty"`
+	// FlowLogsFileIncludeProcess is used to configure if process information is included in a Flow log entry written
+	// to file. This only has an effect when FlowLogsCollectProcessInfo is enabled. [Default: true]
+	FlowLogsFileIncludeProcess *bool `json:"flowLogsFileIncludeProcess,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// FlowLogsFileMaxProcessNameLength truncates process names and paths in Flow log entries written to file to
+	// this many characters. Set to 0 for no limit. [Default: 0]
+	FlowLogsFileMaxProcessNameLength *int `json:"flowLogsFileMaxProcessNameLength,omitempty" validate:"omitempty,gte=0"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
 	// FlowLogsFileAggregationKindForAllowed is used to choose the type of aggregation for flow log entries created for
 	// allowed connections. [Default: 2 - pod prefix name based aggregation].
 	// Accepted values are 0, 1 and 2.
 	// 0 - No aggregation
 	// 1 - Source port based aggregation
 	// 2 - Pod prefix name based aggreagation.
-	FlowLogsFileAggregationKindForAllowed *int `json:"flowLogsFileAggregationKindForAllowed,omitempty" validate:"omitempty,flowLogAggregationKind"`
+	FlowLogsFileAggregationKindForAllowed *int `json:"flowLogsFileAggregationKindForAllowed,omitempty" validate:"omitempty,gte=0,lte=2"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3
 	// FlowLogsFileAggregationKindForDenied is used to choose the type of aggregation for flow log entries created for
 	// denied connections. [Default: 1 - source port based aggregation].
-	// Accepted values are 0, 1 and 2.
+	// Accepted values are 0, 1, 2 and 3.
 	// 0 - No aggregation
 	// 1 - Source port based aggregation
 	// 2 - Pod prefix name based aggregation.
 	// 3 - No destination ports based aggregation
-	FlowLogsFileAggregationKindForDenied *int `json:"flowLogsFileAggregationKindForDenied,omitempty" validate:"omitempty,flowLogAggregationKind"`
+	FlowLogsFileAggregationKindForDenied *int `json:"flowLogsFileAggregationKindForDenied,omitempty" validate:"omitempty,gte=0,lte=3"`
 	// FlowLogsFileEnabledForAllowed is used to enable/disable flow logs entries created for allowed connections. Default is true.
 	// This parameter only takes effect when FlowLogsFileReporterEnabled is set to true.
 	FlowLogsFileEnabledForAllowed *bool `json:"flowLogsFileEnabledForAllowed,omitempty"`
@@ -468,8 +770,50 @@ type FelixConfigurationSpec struct {
 	// beyond which process information will be aggregated. [Default: 2]
 	FlowLogsFilePerFlowProcessLimit *int `json:"flowLogsFilePerFlowProcessLimit,omitempty" validate:"omitempty"`
 
+	// FlowLogsS3Enabled when set to true, enables exporting flow logs to an S3 bucket. FlowLogsS3BucketName and
+	// FlowLogsS3Region must be set when this is enabled. [Default: false]
+	FlowLogsS3Enabled *bool `json:"flowLogsS3Enabled,omitempty"`
+	// FlowLogsS3BucketName is the name of the S3 bucket that flow logs are exported to.
+	FlowLogsS3BucketName string `json:"flowLogsS3BucketName,omitempty"`
+	// FlowLogsS3Region is the region of the S3 bucket that flow logs are exported to.
+	FlowLogsS3Region string `json:"flowLogsS3Region,omitempty"`
+	// FlowLogsS3Prefix is the key prefix under which flow logs are written in the S3 bucket. [Default: ""]
+	FlowLogsS3Prefix string `json:"flowLogsS3Prefix,omitempty"`
+	// FlowLogsS3EncryptionKeyARN is the ARN of the KMS key used to encrypt flow logs written to the S3 bucket.
+	// When unset, the bucket's default encryption is used.
+	FlowLogsS3EncryptionKeyARN string `json:"flowLogsS3EncryptionKeyARN,omitempty"`
+	// FlowLogsS3EndpointURL overrides the S3 endpoint, for use with S3-compatible stores other than AWS.
+	// When unset, the AWS endpoint for FlowLogsS3Region is used.
+	FlowLogsS3EndpointURL string `json:"flowLogsS3EndpointURL,omitempty" validate:"omitempty,url"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// FlowLogsS3AggregationKindForAllowed is used to choose the type of aggregation for flow log entries exported to
+	// S3 for allowed connections. Accepts the same values as FlowLogsFileAggregationKindForAllowed.
+	// [Default: 2 - pod prefix name based aggregation].
+	FlowLogsS3AggregationKindForAllowed *int `json:"flowLogsS3AggregationKindForAllowed,omitempty" validate:"omitempty,gte=0,lte=2"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3
+	// FlowLogsS3AggregationKindForDenied is used to choose the type of aggregation for flow log entries exported to
+	// S3 for denied connections. Accepts the same values as FlowLogsFileAggregationKindForDenied.
+	// [Default: 1 - source port based aggregation].
+	FlowLogsS3AggregationKindForDenied *int `json:"flowLogsS3AggregationKindForDenied,omitempty" validate:"omitempty,gte=0,lte=3"`
+	// FlowLogsS3EnabledForAllowed is used to enable/disable exporting flow log entries to S3 for allowed connections.
+	// Default is true. This parameter only takes effect when FlowLogsS3Enabled is set to true.
+	FlowLogsS3EnabledForAllowed *bool `json:"flowLogsS3EnabledForAllowed,omitempty"`
+	// FlowLogsS3EnabledForDenied is used to enable/disable exporting flow log entries to S3 for denied connections.
+	// Default is true. This parameter only takes effect when FlowLogsS3Enabled is set to true.
+	FlowLogsS3EnabledForDenied *bool `json:"flowLogsS3EnabledForDenied,omitempty"`
+
 	// WindowsFlowLogsFileDirectory sets the directory where flow logs files are stored on Windows nodes. [Default: "c:\\TigeraCalico\\flowlogs"].
 	WindowsFlowLogsFileDirectory string `json:"windowsFlowLogsFileDirectory,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// WindowsFlowLogsMaxFileSizeMB sets the max size in MB of flow logs files on Windows nodes before rotation. When set,
+	// it overrides FlowLogsFileMaxFileSizeMB on Windows nodes.
+	WindowsFlowLogsMaxFileSizeMB *int `json:"windowsFlowLogsMaxFileSizeMB,omitempty" validate:"omitempty,gt=0"`
+	// +kubebuilder:validation:Minimum=1
+	// WindowsFlowLogsMaxFiles sets the number of flow log files to keep on Windows nodes. When set, it overrides
+	// FlowLogsFileMaxFiles on Windows nodes.
+	WindowsFlowLogsMaxFiles *int `json:"windowsFlowLogsMaxFiles,omitempty" validate:"omitempty,gt=0"`
 	// WindowsFlowLogsPositionFilePath is used to specify the position of the external pipeline that reads flow logs on Windows nodes.
 	// [Default: "c:\\TigeraCalico\\flowlogs\\flows.log.pos"].
 	// This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.
@@ -516,6 +860,10 @@ type FelixConfigurationSpec struct {
 	// DNSLogsFileMaxFileSizeMB sets the max size in MB of DNS log files before rotation.
 	// [Default: 100]
 	DNSLogsFileMaxFileSizeMB *int `json:"dnsLogsFileMaxFileSizeMB,omitempty"`
+	// DNSLogsFileCompressionEnabled when set to true, compresses DNS log files with gzip after they are rotated.
+	// The active log file is never compressed.
+	// [Default: false]
+	DNSLogsFileCompressionEnabled *bool `json:"dnsLogsFileCompressionEnabled,omitempty"`
 	// DNSLogsFileDirectory sets the directory where DNS log files are stored.
 	// [Default: /var/log/calico/dnslogs]
 	DNSLogsFileDirectory *string `json:"dnsLogsFileDirectory,omitempty"`
@@ -533,6 +881,18 @@ type FelixConfigurationSpec struct {
 	// the flush interval, and emits a WARNING log with that count at the same time as it
 	// flushes the buffered DNS logs.  [Default: 0, meaning no limit]
 	DNSLogsFilePerNodeLimit *int `json:"dnsLogsFilePerNodeLimit,omitempty"`
+	// DNSLogsS3Enabled when set to true, enables exporting DNS logs to an S3 bucket. DNSLogsS3BucketName and
+	// DNSLogsS3Region must be set when this is enabled. [Default: false]
+	DNSLogsS3Enabled *bool `json:"dnsLogsS3Enabled,omitempty"`
+	// DNSLogsS3BucketName is the name of the S3 bucket that DNS logs are exported to.
+	DNSLogsS3BucketName string `json:"dnsLogsS3BucketName,omitempty"`
+	// DNSLogsS3Region is the region of the S3 bucket that DNS logs are exported to.
+	DNSLogsS3Region string `json:"dnsLogsS3Region,omitempty"`
+	// DNSLogsS3Prefix is the key prefix under which DNS logs are written in the S3 bucket. [Default: ""]
+	DNSLogsS3Prefix string `json:"dnsLogsS3Prefix,omitempty"`
+	// Limit on the number of DNS logs that can be exported to S3 within each flush interval.  Has the same
+	// semantics as DNSLogsFilePerNodeLimit.  [Default: 0, meaning no limit]
+	DNSLogsS3PerNodeLimit *int `json:"dnsLogsS3PerNodeLimit,omitempty"`
 	// DNSLogsLatency indicates to include measurements of DNS request/response latency in each DNS log.
 	// [Default: true]
 	DNSLogsLatency *bool `json:"dnsLogsLatency,omitempty"`
@@ -549,6 +909,10 @@ type FelixConfigurationSpec struct {
 	// L7LogsFileMaxFileSizeMB sets the max size in MB of L7 log files before rotation.
 	// [Default: 100]
 	L7LogsFileMaxFileSizeMB *int `json:"l7LogsFileMaxFileSizeMB,omitempty"`
+	// L7LogsFileCompressionEnabled when set to true, compresses L7 log files with gzip after they are rotated.
+	// The active log file is never compressed.
+	// [Default: false]
+	L7LogsFileCompressionEnabled *bool `json:"l7LogsFileCompressionEnabled,omitempty"`
 	// L7LogsFileDirectory sets the directory where L7 log files are stored.
 	// [Default: /var/log/calico/l7logs]
 	L7LogsFileDirectory *string `json:"l7LogsFileDirectory,omitempty"`
@@ -558,6 +922,14 @@ type FelixConfigurationSpec struct {
 	// IncludeL7HTTPHeaderInfo - Include HTTP header data in the logs.
 	// ExcludeL7HTTPHeaderInfo - Aggregate over all other fields ignoring the user agent and log type.
 	L7LogsFileAggregationHTTPHeaderInfo *string `json:"l7LogsFileAggregationHTTPHeaderInfo,omitempty" validate:"omitempty,l7HTTPHeaderAggregation"`
+	// +kubebuilder:validation:Enum=FullString;MajorVersion;Exclude
+	// L7LogsFileAggregationUserAgent is used to choose the type of aggregation for the user agent on L7 log entries.
+	// When set, it takes precedence over the user agent handling of L7LogsFileAggregationHTTPHeaderInfo.
+	// Accepted values are FullString, MajorVersion and Exclude.
+	// FullString - Include the full user agent string.
+	// MajorVersion - Include only the product name and major version of the user agent, e.g. "Mozilla/5".
+	// Exclude - Remove the user agent from the logs.
+	L7LogsFileAggregationUserAgent string `json:"l7LogsFileAggregationUserAgent,omitempty" validate:"omitempty,oneof=FullString MajorVersion Exclude"`
 	// L7LogsFileAggregationHTTPMethod is used to choose the type of aggregation for the HTTP request method on L7 log entries.
 	// [Default: IncludeL7HTTPMethod - include the HTTP method].
 	// Accepted values are IncludeL7HTTPMethod and ExcludeL7HTTPMethod.
@@ -610,11 +982,48 @@ type FelixConfigurationSpec struct {
 	// the flush interval, and emits a WARNING log with that count at the same time as it
 	// flushes the buffered L7 logs. A value of 0 means no limit. [Default: 1500]
 	L7LogsFilePerNodeLimit *int `json:"l7LogsFilePerNodeLimit,omitempty"`
+	// L7LogsFileRequestIDHeader is the name of an HTTP request header, such as X-Request-ID or X-B3-TraceId, whose
+	// value Felix includes in each L7 log entry as the request_id field. This allows L7 logs to be correlated with
+	// distributed traces. When empty, no request ID is recorded. [Default: ""]
+	L7LogsFileRequestIDHeader string `json:"l7LogsFileRequestIDHeader,omitempty" validate:"omitempty,httpHeaderName"`
+	// L7LogsS3Enabled when set to true, enables exporting L7 logs to an S3 bucket. L7LogsS3BucketName and
+	// L7LogsS3Region must be set when this is enabled. [Default: false]
+	L7LogsS3Enabled *bool `json:"l7LogsS3Enabled,omitempty"`
+	// L7LogsS3BucketName is the name of the S3 bucket that L7 logs are exported to.
+	L7LogsS3BucketName string `json:"l7LogsS3BucketName,omitempty"`
+	// L7LogsS3Region is the region of the S3 bucket that L7 logs are exported to.
+	L7LogsS3Region string `json:"l7LogsS3Region,omitempty"`
+	// L7LogsS3Prefix is the key prefix under which L7 logs are written in the S3 bucket. [Default: ""]
+	L7LogsS3Prefix string `json:"l7LogsS3Prefix,omitempty"`
+	// L7LogsElasticsearchEnabled when set to true, enables pushing L7 logs directly to Elasticsearch, without writing
+	// them to file first. L7LogsElasticsearchEndpoint must be set when this is enabled. [Default: false]
+	L7LogsElasticsearchEnabled *bool `json:"l7LogsElasticsearchEnabled,omitempty"`
+	// L7LogsElasticsearchEndpoint is the URL of the Elasticsearch cluster that L7 logs are pushed to.
+	L7LogsElasticsearchEndpoint string `json:"l7LogsElasticsearchEndpoint,omitempty" validate:"omitempty,url"`
+	// L7LogsElasticsearchIndex is the name of the Elasticsearch index that L7 logs are written to.
+	L7LogsElasticsearchIndex string `json:"l7LogsElasticsearchIndex,omitempty"`
 
 	// WindowsNetworkName specifies which Windows HNS networks Felix should operate on.  The default is to match
 	// networks that start with "calico".  Supports regular expression syntax.
 	WindowsNetworkName *string `json:"windowsNetworkName,omitempty"`
 
+	// WindowsVXLANEnabled overrides VXLANEnabled on Windows nodes. [Default: unset - VXLANEnabled applies]
+	WindowsVXLANEnabled *bool `json:"windowsVXLANEnabled,omitempty"`
+	// WindowsVXLANMTU is the MTU to set on the HNS VXLAN network on Windows nodes. When set, it overrides VXLANMTU on
+	// Windows nodes. [Default: unset - VXLANMTU applies]
+	WindowsVXLANMTU *int `json:"windowsVXLANMTU,omitempty" validate:"omitempty,gt=0"`
+	// WindowsVXLANPort overrides VXLANPort on Windows nodes. [Default: unset - VXLANPort applies]
+	WindowsVXLANPort *int `json:"windowsVXLANPort,omitempty"`
+	// WindowsVXLANVNI overrides VXLANVNI on Windows nodes. [Default: unset - VXLANVNI applies]
+	WindowsVXLANVNI *int `json:"windowsVXLANVNI,omitempty"`
+	// WindowsBPFEnabled, if enabled, Felix uses the eBPF for Windows APIs instead of HNS to implement the dataplane
+	// on Windows nodes.  It is independent of BPFEnabled, which only applies to Linux nodes.  This is an experimental
+	// feature.  [Default: false]
+	WindowsBPFEnabled *bool `json:"windowsBPFEnabled,omitempty" validate:"omitempty"`
+	// WindowsBPFLogLevel controls the log level of the BPF programs on Windows nodes when WindowsBPFEnabled is true.
+	// One of "Off", "Info", or "Debug", as for BPFLogLevel.  [Default: Off].
+	WindowsBPFLogLevel string `json:"windowsBPFLogLevel,omitempty" validate:"omitempty,oneof=Off Info Debug"`
+
 	// RouteSource configures where Felix gets its routing information.
 	// - WorkloadIPs: use workload endpoints to construct routes.
 	// - CalicoIPAM: the default - use IPAM data to construct routes.
@@ -622,8 +1031,16 @@ type FelixConfigurationSpec struct {
 
 	// Calico programs additional Linux route tables for various purposes.  RouteTableRange
 	// specifies the indices of the route tables that Calico should use.
+	//
+	// Deprecated: Use RouteTableRanges instead.
 	RouteTableRange *RouteTableRange `json:"routeTableRange,omitempty" validate:"omitempty"`
 
+	// Calico programs additional Linux route tables for various purposes.  RouteTableRanges
+	// specifies a set of table index ranges that Calico should use.  The ranges must not overlap, each range
+	// must have Min <= Max, and indices must be between 1 and 2147483647 (tables 253-255 are reserved by the
+	// kernel).  Takes precedence over RouteTableRange.
+	RouteTableRanges *[]RouteTableRange `json:"routeTableRanges,omitempty" validate:"omitempty,dive"`
+
 	// EgressIPSupport defines three different support modes for egress IP function. [Default: Disabled]
 	// - Disabled:                    Egress IP function is disabled.
 	// - EnabledPerNamespace:         Egress IP function is enabled and can be configured on a per-namespace basis;
@@ -637,19 +1054,48 @@ type FelixConfigurationSpec struct {
 	EgressIPVXLANVNI *int `json:"egressIPVXLANVNI,omitempty"`
 	// EgressIPRoutingRulePriority controls the priority value to use for the egress IP routing rule. [Default: 100]
 	EgressIPRoutingRulePriority *int `json:"egressIPRoutingRulePriority,omitempty" validate:"omitempty,gt=0,lt=32766"`
+	// EgressIPHealthTimeoutDatastore is the time after which an egress gateway is treated as failed if its health
+	// has not been refreshed in the datastore. [Default: 90s]
+	EgressIPHealthTimeoutDatastore *metav1.Duration `json:"egressIPHealthTimeoutDatastore,omitempty" configv1timescale:"seconds"`
+	// EgressIPHealthTimeoutICMP is the time after which an egress gateway is treated as failed if it has not responded
+	// to ICMP probes. Set to 0 to disable ICMP-based failure detection. [Default: 0]
+	EgressIPHealthTimeoutICMP *metav1.Duration `json:"egressIPHealthTimeoutICMP,omitempty" configv1timescale:"seconds"`
+	// +kubebuilder:validation:Minimum=1
+	// EgressIPVXLANMTU is the MTU to use for the vxlan tunnel device for egress traffic, independently of the
+	// MTU of the workload VXLAN device. [Default: unset - derived from the host MTU]
+	EgressIPVXLANMTU *int `json:"egressIPVXLANMTU,omitempty" validate:"omitempty,gt=0"`
 
 	// WireguardEnabled controls whether Wireguard is enabled. [Default: false]
 	WireguardEnabled *bool `json:"wireguardEnabled,omitempty"`
 	// WireguardListeningPort controls the listening port used by Wireguard. [Default: 51820]
 	WireguardListeningPort *int `json:"wireguardListeningPort,omitempty" validate:"omitempty,gt=0,lte=65535"`
+	// WireguardEnabledV6 controls whether Wireguard is enabled for IPv6 (encapsulating IPv6 traffic over an IPv6 underlay network). [Default: false]
+	WireguardEnabledV6 *bool `json:"wireguardEnabledV6,omitempty"`
+	// WireguardListeningPortV6 controls the listening port used by IPv6 Wireguard. [Default: 51821]
+	WireguardListeningPortV6 *int `json:"wireguardListeningPortV6,omitempty" validate:"omitempty,gt=0,lte=65535"`
 	// WireguardRoutingRulePriority controls the priority value to use for the Wireguard routing rule. [Default: 99]
 	WireguardRoutingRulePriority *int `json:"wireguardRoutingRulePriority,omitempty" validate:"omitempty,gt=0,lt=32766"`
 	// WireguardInterfaceName specifies the name to use for the Wireguard interface. [Default: wg.calico]
 	WireguardInterfaceName string `json:"wireguardInterfaceName,omitempty" validate:"omitempty,interface"`
+	// WireguardInterfaceNameV6 specifies the name to use for the IPv6 Wireguard interface. [Default: wg-v6.calico]
+	WireguardInterfaceNameV6 string `json:"wireguardInterfaceNameV6,omitempty" validate:"omitempty,interface"`
 	// WireguardMTU controls the MTU on the Wireguard interface. See Configuring MTU [Default: 1420]
 	WireguardMTU *int `json:"wireguardMTU,omitempty"`
 	// WireguardHostEncryptionEnabled controls whether Wireguard host-to-host encryption is enabled. [Default: false]
 	WireguardHostEncryptionEnabled *bool `json:"wireguardHostEncryptionEnabled,omitempty"`
+	// WireguardAllowedCIDRs restricts the traffic that is routed through the Wireguard interface. When non-empty, only
+	// traffic to these CIDRs is sent over Wireguard; other inter-node traffic bypasses it. [Default: unset - all
+	// inter-node traffic uses Wireguard]
+	WireguardAllowedCIDRs *[]string `json:"wireguardAllowedCIDRs,omitempty" validate:"omitempty,dive,cidr"`
+	// WireguardPersistentKeepAlive controls the Wireguard PersistentKeepalive option, the interval at which
+	// keepalive packets are sent to peers so that stateful firewalls do not drop idle tunnels. Must not be negative.
+	// Set to 0 to disable. [Default: 0]
+	WireguardPersistentKeepAlive *metav1.Duration `json:"wireguardKeepAlive,omitempty" configv1timescale:"seconds"`
+	// WireguardPresharedKey is the name of a Kubernetes Secret that holds a preshared key to add to the Wireguard
+	// handshake as an extra layer of authentication. This must be a Secret name, not the key itself. [Default: unset]
+	WireguardPresharedKey string `json:"wireguardPresharedKey,omitempty" validate:"omitempty,name"`
+	// WireguardPresharedKeyNamespace is the namespace of the Secret named by WireguardPresharedKey.
+	WireguardPresharedKeyNamespace string `json:"wireguardPresharedKeyNamespace,omitempty" validate:"omitempty,name"`
 
 	// +kubebuilder:validation:MinLength=1
 	// CaptureDir controls directory to store file capture. [Default: /var/log/calico/pcap]
@@ -667,6 +1113,29 @@ type FelixConfigurationSpec struct {
 	// CaptureMaxFiles controls number of rotated capture file to keep. [Default: 2]
 	CaptureMaxFiles *int `json:"captureMaxFiles,omitempty" validate:"omitempty,gt=0"`
 
+	// +kubebuilder:validation:MinLength=1
+	// CaptureFilter is a BPF filter expression, in libpcap syntax, that is applied to packet captures that do not
+	// specify their own filter. [Default: unset - capture all packets]
+	CaptureFilter string `json:"captureFilter,omitempty" validate:"omitempty,bpfFilter"`
+
+	// +kubebuilder:validation:Minimum=1
+	// CaptureSnapLen controls the maximum number of bytes captured from each packet. [Default: 65535]
+	CaptureSnapLen *int `json:"captureSnapLen,omitempty" validate:"omitempty,gt=0"`
+
+	// PCAPRemoteStreamEndpoint is the <host>:<port> of a remote collector to which Felix streams captured packets, in
+	// pcapng format, as they are captured. Packets are streamed in addition to being written to CaptureDir, or instead
+	// of it when CaptureDir is empty. [Default: unset - streaming is disabled]
+	PCAPRemoteStreamEndpoint string `json:"pcapRemoteStreamEndpoint,omitempty"`
+
+	// +kubebuilder:validation:Enum=UDP;TCP;GRPC
+	// PCAPRemoteStreamProtocol is the protocol used to stream captured packets to PCAPRemoteStreamEndpoint.
+	// Accepted values are "UDP", "TCP" or "GRPC". [Default: TCP]
+	PCAPRemoteStreamProtocol string `json:"pcapRemoteStreamProtocol,omitempty" validate:"omitempty,oneof=UDP TCP GRPC"`
+
+	// PCAPRemoteStreamCAFile is the path to the CA bundle used to verify the certificate of PCAPRemoteStreamEndpoint.
+	// When set, the stream to the remote endpoint is secured with TLS.
+	PCAPRemoteStreamCAFile string `json:"pcapRemoteStreamCAFile,omitempty"`
+
 	// Set source-destination-check on AWS EC2 instances. Accepted value must be one of "DoNothing", "Enabled" or "Disabled".
 	// [Default: DoNothing]
 	AWSSrcDstCheck *AWSSrcDstCheckOption `json:"awsSrcDstCheck,omitempty" validate:"omitempty,oneof=DoNothing Enable Disable"`
@@ -677,6 +1146,17 @@ type FelixConfigurationSpec struct {
 	// [Default: Drop]
 	ServiceLoopPrevention string `json:"serviceLoopPrevention,omitempty" validate:"omitempty,oneof=Drop Reject Disabled"`
 
+	// ServiceLoopPreventionExemptCIDRs is a list of CIDRs that are exempt from ServiceLoopPrevention. Packets to
+	// service IPs within these CIDRs are not dropped or rejected, even if they do not get DNAT'd by kube-proxy. This
+	// is useful where real traffic is routed to service IPs, for example with MetalLB. [Default: unset]
+	ServiceLoopPreventionExemptCIDRs *[]string `json:"serviceLoopPreventionExemptCIDRs,omitempty" validate:"omitempty,dive,cidr"`
+
+	// ServiceCIDRs is the list of Kubernetes service CIDRs that Felix treats as virtual service IPs rather than as
+	// external destinations.  In a dual-stack cluster, list one IPv4 and one IPv6 CIDR.  ServiceLoopPrevention
+	// applies to these CIDRs, so traffic to an unused service IP within them is dropped or rejected as configured.
+	// [Default: unset - Felix derives the service CIDR from its other configuration]
+	ServiceCIDRs *[]string `json:"serviceCIDRs,omitempty" validate:"omitempty,dive,cidr"`
+
 	// MTUIfacePattern is a regular expression that controls which interfaces Felix should scan in order
 	// to calculate the host's MTU.
 	// This should not match workload interfaces (usually named cali...).
@@ -690,19 +1170,442 @@ type FelixConfigurationSpec struct {
 	// TPROXYPort sets to which port proxied traffic should be redirected.
 	// [Default: 16001]
 	TPROXYPort *int `json:"tproxyPort,omitempty" validate:"omitempty,gt=0,lte=65535"`
+	// TPROXYMark is the mark that Felix sets on traffic that is intercepted by the transparent proxy, so that it is
+	// routed to the proxy. Should be a 32 bit hexadecimal number whose bits are all within TPROXYMarkMask. Only
+	// used when TPROXYMode is not Disabled. [Default: 0x00000800]
+	TPROXYMark *uint32 `json:"tproxyMark,omitempty"`
+	// TPROXYMarkMask is the mask that is applied when matching TPROXYMark. [Default: 0x00000800]
+	TPROXYMarkMask *uint32 `json:"tproxyMarkMask,omitempty"`
+
+	// NfqueueEnabled is an experimental integration point that, like TPROXYMode, hands traffic to a userspace
+	// process; when enabled, Felix sends packets that are allowed by policy to the Linux NFQUEUE given by
+	// NfqueueNumber for further processing.  [Default: false]
+	NfqueueEnabled *bool `json:"nfqueueEnabled,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// NfqueueNumber is the NFQUEUE queue number that Felix sends packets to when NfqueueEnabled is true.
+	// [Default: 0]
+	NfqueueNumber *int `json:"nfqueueNumber,omitempty" validate:"omitempty,gte=0,lte=65535"`
+	// NfqueueBypassForIntraNode, when NfqueueEnabled is true, skips the NFQUEUE for traffic between workloads on
+	// the same node.  [Default: false]
+	NfqueueBypassForIntraNode *bool `json:"nfqueueBypassForIntraNode,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="self.min <= self.max",message="min must not be greater than max"
 type RouteTableRange struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483647
 	Min int `json:"min"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483647
 	Max int `json:"max"`
 }
 
-// ProtoPort is combination of protocol, port, and CIDR. Protocol and port must be specified.
+// Validate returns an error if the range is empty or extends outside the route table indices supported by
+// the kernel.
+func (r RouteTableRange) Validate() error {
+	if r.Min < 1 {
+		return fmt.Errorf("route table range min %d must be at least 1", r.Min)
+	}
+	if r.Max > math.MaxInt32 {
+		return fmt.Errorf("route table range max %d must be at most %d", r.Max, math.MaxInt32)
+	}
+	if r.Min > r.Max {
+		return fmt.Errorf("route table range min %d must not be greater than max %d", r.Min, r.Max)
+	}
+	return nil
+}
+
+// Route table indices that the kernel reserves for its default, main and local tables.
+const (
+	routeTableDefault = 253
+	routeTableLocal   = 255
+)
+
+// ValidateRouteTableRanges returns an error if any of the given ranges is invalid, includes one of the route tables
+// reserved by the kernel (253-255), or overlaps another range.
+func ValidateRouteTableRanges(ranges []RouteTableRange) error {
+	for _, r := range ranges {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if r.Min <= routeTableLocal && r.Max >= routeTableDefault {
+			return fmt.Errorf("route table range %d-%d includes the reserved tables %d-%d",
+				r.Min, r.Max, routeTableDefault, routeTableLocal)
+		}
+	}
+
+	sorted := make([]RouteTableRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Min < sorted[j].Min })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Min <= sorted[i-1].Max {
+			return fmt.Errorf("route table range %d-%d overlaps range %d-%d",
+				sorted[i].Min, sorted[i].Max, sorted[i-1].Min, sorted[i-1].Max)
+		}
+	}
+	return nil
+}
+
+// ProtoPort is combination of protocol, port, and CIDR. Protocol and port must be specified, except
+// for ICMP entries, which specify an ICMP type (and optionally code) in place of the port.
 type ProtoPort struct {
 	Protocol string `json:"protocol"`
 	Port     uint16 `json:"port"`
 	// +optional
 	Net string `json:"net"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// ICMPType restricts an "icmp" or "icmpv6" entry to a single ICMP type.  May only be set when
+	// Protocol is "icmp" or "icmpv6", in which case Port must be zero.
+	// +optional
+	ICMPType *int `json:"icmpType,omitempty" validate:"omitempty,gte=0,lte=255"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// ICMPCode restricts an "icmp" or "icmpv6" entry to a single ICMP code.  May only be set when
+	// Protocol is "icmp" or "icmpv6".
+	// +optional
+	ICMPCode *int `json:"icmpCode,omitempty" validate:"omitempty,gte=0,lte=255"`
+}
+
+// Validate implements the protoPort validator.  It returns an error if ICMPType or ICMPCode is set when Protocol is
+// not "icmp" or "icmpv6", or if both ICMPType and Port are set.
+func (p ProtoPort) Validate() error {
+	if p.ICMPType == nil && p.ICMPCode == nil {
+		return nil
+	}
+	if !strings.EqualFold(p.Protocol, "icmp") && !strings.EqualFold(p.Protocol, "icmpv6") {
+		return fmt.Errorf("icmpType and icmpCode may only be set when protocol is icmp or icmpv6, got %q", p.Protocol)
+	}
+	if p.ICMPType != nil && p.Port != 0 {
+		return fmt.Errorf("port must not be set when icmpType is set")
+	}
+	return nil
+}
+
+// FeatureDetectOverrides overrides the result of Felix's feature detection.  A nil field means that the
+// feature is auto-detected.
+type FeatureDetectOverrides struct {
+	SNATFullyRandom        *bool `json:"snatFullyRandom,omitempty"`
+	MASQFullyRandom        *bool `json:"masqFullyRandom,omitempty"`
+	RestoreSupportsLock    *bool `json:"restoreSupportsLock,omitempty"`
+	BPFKTimeGetNSSupported *bool `json:"bpfKTimeGetNSSupported,omitempty"`
+}
+
+// FeatureDetectOverridesFromString converts the deprecated FeatureDetectOverride string representation, for
+// example "SNATFullyRandom=true,MASQFullyRandom=false,RestoreSupportsLock=", into a FeatureDetectOverrides.
+// Empty values leave the feature auto-detected.  An error is returned for unknown features and for values
+// other than "true", "false" or empty.
+func FeatureDetectOverridesFromString(s string) (*FeatureDetectOverrides, error) {
+	overrides := &FeatureDetectOverrides{}
+	if s == "" {
+		return overrides, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid feature detect override %q: expected <feature>=<value>", kv)
+		}
+		var value *bool
+		switch parts[1] {
+		case "":
+		case "true", "false":
+			b := parts[1] == "true"
+			value = &b
+		default:
+			return nil, fmt.Errorf("invalid value %q for feature %s: expected true, false or empty", parts[1], parts[0])
+		}
+		switch parts[0] {
+		case "SNATFullyRandom":
+			overrides.SNATFullyRandom = value
+		case "MASQFullyRandom":
+			overrides.MASQFullyRandom = value
+		case "RestoreSupportsLock":
+			overrides.RestoreSupportsLock = value
+		case "BPFKTimeGetNSSupported":
+			overrides.BPFKTimeGetNSSupported = value
+		default:
+			return nil, fmt.Errorf("unknown feature %q", parts[0])
+		}
+	}
+	return overrides, nil
+}
+
+// felixConfigurationSpecValidators are the cross-field checks that FelixConfigurationSpec.Validate runs, in order.
+var felixConfigurationSpecValidators = []func(*FelixConfigurationSpec) error{
+	ValidateFlowLogAggregationConsistency,
+	ValidateServiceCIDRs,
+	ValidateBPFInterfaceLogFilters,
+	ValidateMaxIpsetLimits,
+	ValidateECMPHashMode,
+	ValidateBPFKubeProxyEndpointHealthzPort,
+	ValidateIPSecStrictMode,
+	ValidateHealthCheckTimeout,
+	ValidateHealthTimeoutOverrides,
+	ValidateFlowLogsS3,
+	ValidateDNSLogsS3,
+	ValidateL7LogsExport,
+	ValidateWireguardPersistentKeepAlive,
+	ValidateTPROXYMark,
+	ValidateFailsafeHostPorts,
+}
+
+// Validate returns the first error reported by the spec's cross-field checks, which cannot be expressed with validate
+// tags, or by the checks on its route table ranges.
+func (s *FelixConfigurationSpec) Validate() error {
+	for _, validate := range felixConfigurationSpecValidators {
+		if err := validate(s); err != nil {
+			return err
+		}
+	}
+	if s.RouteTableRange != nil {
+		if err := s.RouteTableRange.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.RouteTableRanges != nil {
+		return ValidateRouteTableRanges(*s.RouteTableRanges)
+	}
+	return nil
+}
+
+// ValidateFlowLogAggregationConsistency returns an error if the spec explicitly enables dynamic flow log aggregation
+// and also sets one of the static file aggregation kinds, which Felix ignores while dynamic aggregation is enabled.
+// Leaving FlowLogsDynamicAggregationEnabled unset is not treated as enabling it.
+func ValidateFlowLogAggregationConsistency(spec *FelixConfigurationSpec) error {
+	if spec.FlowLogsDynamicAggregationEnabled == nil || !*spec.FlowLogsDynamicAggregationEnabled {
+		return nil
+	}
+	if spec.FlowLogsFileAggregationKindForAllowed != nil {
+		return fmt.Errorf("flowLogsFileAggregationKindForAllowed must not be set when flowLogsDynamicAggregationEnabled is true")
+	}
+	if spec.FlowLogsFileAggregationKindForDenied != nil {
+		return fmt.Errorf("flowLogsFileAggregationKindForDenied must not be set when flowLogsDynamicAggregationEnabled is true")
+	}
+	return nil
+}
+
+// ValidateServiceCIDRs returns an error if the spec's ServiceCIDRs cannot describe a Kubernetes service network.  As
+// with the Kubernetes API server, there may be at most two service CIDRs and, if there are two, one must be IPv4 and
+// the other IPv6.
+func ValidateServiceCIDRs(spec *FelixConfigurationSpec) error {
+	if spec.ServiceCIDRs == nil {
+		return nil
+	}
+	cidrs := *spec.ServiceCIDRs
+	if len(cidrs) > 2 {
+		return fmt.Errorf("serviceCIDRs must contain at most one IPv4 and one IPv6 CIDR, got %d CIDRs", len(cidrs))
+	}
+	var numV4 int
+	for _, c := range cidrs {
+		ip, _, err := net.ParseCIDR(c)
+		if err != nil {
+			return fmt.Errorf("serviceCIDRs contains invalid CIDR %q: %v", c, err)
+		}
+		if ip.To4() != nil {
+			numV4++
+		}
+	}
+	if len(cidrs) == 2 && numV4 != 1 {
+		return fmt.Errorf("serviceCIDRs must contain at most one IPv4 and one IPv6 CIDR")
+	}
+	return nil
+}
+
+// ValidateBPFInterfaceLogFilters returns an error if any key of the spec's BPFInterfaceLogFilters is not a valid
+// regular expression or any value is not a BPF log level.
+func ValidateBPFInterfaceLogFilters(spec *FelixConfigurationSpec) error {
+	if spec.BPFInterfaceLogFilters == nil {
+		return nil
+	}
+	for pattern, level := range *spec.BPFInterfaceLogFilters {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("bpfInterfaceLogFilters pattern %q is not a valid regular expression: %v", pattern, err)
+		}
+		switch level {
+		case "Off", "Info", "Debug":
+		default:
+			return fmt.Errorf("bpfInterfaceLogFilters pattern %q has invalid log level %q", pattern, level)
+		}
+	}
+	return nil
+}
+
+// ValidateMaxIpsetLimits returns an error if both MaxIpsetSoftLimit and MaxIpsetHardLimit are set and the soft limit
+// is greater than the hard limit.
+func ValidateMaxIpsetLimits(spec *FelixConfigurationSpec) error {
+	if spec.MaxIpsetSoftLimit == nil || spec.MaxIpsetHardLimit == nil {
+		return nil
+	}
+	if *spec.MaxIpsetSoftLimit > *spec.MaxIpsetHardLimit {
+		return fmt.Errorf("maxIpsetSoftLimit (%d) must not be greater than maxIpsetHardLimit (%d)",
+			*spec.MaxIpsetSoftLimit, *spec.MaxIpsetHardLimit)
+	}
+	return nil
+}
+
+// ValidateECMPHashMode returns an error if ECMPHashMode is set without ECMPEnabled being true, since the hash mode
+// only applies to equal-cost multipath routes.
+func ValidateECMPHashMode(spec *FelixConfigurationSpec) error {
+	if spec.ECMPHashMode == "" {
+		return nil
+	}
+	if spec.ECMPEnabled == nil || !*spec.ECMPEnabled {
+		return fmt.Errorf("ecmpHashMode may only be set when ecmpEnabled is true")
+	}
+	return nil
+}
+
+// defaultHealthPort is the port that Felix's health endpoint listens on when HealthPort is not set.
+const defaultHealthPort = 9099
+
+// ValidateBPFKubeProxyEndpointHealthzPort returns an error if BPFKubeProxyEndpointHealthzPort is set to the same port
+// as another Felix listener: the health endpoint (HealthPort, or its default of 9099 if unset) or, where they are set,
+// the Prometheus metrics and reporter ports.
+func ValidateBPFKubeProxyEndpointHealthzPort(spec *FelixConfigurationSpec) error {
+	if spec.BPFKubeProxyEndpointHealthzPort == nil {
+		return nil
+	}
+	port := *spec.BPFKubeProxyEndpointHealthzPort
+
+	healthPort := defaultHealthPort
+	if spec.HealthPort != nil {
+		healthPort = *spec.HealthPort
+	}
+	if port == healthPort {
+		return fmt.Errorf("bpfKubeProxyEndpointHealthzPort %d clashes with healthPort", port)
+	}
+	if spec.PrometheusMetricsPort != nil && port == *spec.PrometheusMetricsPort {
+		return fmt.Errorf("bpfKubeProxyEndpointHealthzPort %d clashes with prometheusMetricsPort", port)
+	}
+	if spec.PrometheusReporterPort != nil && port == *spec.PrometheusReporterPort {
+		return fmt.Errorf("bpfKubeProxyEndpointHealthzPort %d clashes with prometheusReporterPort", port)
+	}
+	return nil
+}
+
+// ValidateIPSecStrictMode returns an error if IPSecStrictMode is enabled while IPSecMode is empty, which would leave
+// Felix dropping IPSec traffic without IPSec being configured.
+func ValidateIPSecStrictMode(spec *FelixConfigurationSpec) error {
+	if spec.IPSecStrictMode != nil && *spec.IPSecStrictMode && spec.IPSecMode == "" {
+		return fmt.Errorf("ipsecStrictMode requires ipsecMode to be set")
+	}
+	return nil
+}
+
+// ValidateHealthCheckTimeout returns an error if HealthCheckTimeout is negative.  Zero is valid and disables the
+// timeout.
+func ValidateHealthCheckTimeout(spec *FelixConfigurationSpec) error {
+	return validateNonNegativeDuration("healthCheckTimeout", spec.HealthCheckTimeout)
+}
+
+// ValidateHealthTimeoutOverrides returns an error if any of the HealthTimeoutOverrides is missing or is not a positive
+// duration.
+func ValidateHealthTimeoutOverrides(spec *FelixConfigurationSpec) error {
+	for name, d := range spec.HealthTimeoutOverrides {
+		if d == nil || d.Duration <= 0 {
+			return fmt.Errorf("healthTimeoutOverrides[%s] must be a positive duration", name)
+		}
+	}
+	return nil
+}
+
+// ValidateFlowLogsS3 returns an error if flow log export to S3 is enabled without both a bucket name and a region.
+func ValidateFlowLogsS3(spec *FelixConfigurationSpec) error {
+	if spec.FlowLogsS3Enabled == nil || !*spec.FlowLogsS3Enabled {
+		return nil
+	}
+	if spec.FlowLogsS3BucketName == "" || spec.FlowLogsS3Region == "" {
+		return fmt.Errorf("flowLogsS3BucketName and flowLogsS3Region must be set when flowLogsS3Enabled is true")
+	}
+	return nil
+}
+
+// ValidateDNSLogsS3 returns an error if DNS log export to S3 is enabled without both a bucket name and a region.
+func ValidateDNSLogsS3(spec *FelixConfigurationSpec) error {
+	if spec.DNSLogsS3Enabled == nil || !*spec.DNSLogsS3Enabled {
+		return nil
+	}
+	if spec.DNSLogsS3BucketName == "" || spec.DNSLogsS3Region == "" {
+		return fmt.Errorf("dnsLogsS3BucketName and dnsLogsS3Region must be set when dnsLogsS3Enabled is true")
+	}
+	return nil
+}
+
+// ValidateL7LogsExport returns an error if L7 log export to S3 is enabled without both a bucket name and a region, or
+// if L7 log export to Elasticsearch is enabled without an endpoint.
+func ValidateL7LogsExport(spec *FelixConfigurationSpec) error {
+	if spec.L7LogsS3Enabled != nil && *spec.L7LogsS3Enabled {
+		if spec.L7LogsS3BucketName == "" || spec.L7LogsS3Region == "" {
+			return fmt.Errorf("l7LogsS3BucketName and l7LogsS3Region must be set when l7LogsS3Enabled is true")
+		}
+	}
+	if spec.L7LogsElasticsearchEnabled != nil && *spec.L7LogsElasticsearchEnabled {
+		if spec.L7LogsElasticsearchEndpoint == "" {
+			return fmt.Errorf("l7LogsElasticsearchEndpoint must be set when l7LogsElasticsearchEnabled is true")
+		}
+	}
+	return nil
+}
+
+// ValidateWireguardPersistentKeepAlive returns an error if WireguardPersistentKeepAlive is negative.  Zero is valid and
+// disables keepalives.
+func ValidateWireguardPersistentKeepAlive(spec *FelixConfigurationSpec) error {
+	return validateNonNegativeDuration("wireguardKeepAlive", spec.WireguardPersistentKeepAlive)
+}
+
+// validateNonNegativeDuration returns an error if d is set to a negative duration.  The field name is used in the
+// error message.
+func validateNonNegativeDuration(field string, d *metav1.Duration) error {
+	if d != nil && d.Duration < 0 {
+		return fmt.Errorf("%s must not be negative, got %v", field, d.Duration)
+	}
+	return nil
+}
+
+// defaultTPROXYMark is the value of both TPROXYMark and TPROXYMarkMask when they are not set.
+const defaultTPROXYMark uint32 = 0x00000800
+
+// ValidateTPROXYMark returns an error if TPROXYMode is enabled and TPROXYMark has bits outside TPROXYMarkMask, which
+// would stop Felix matching the traffic that it marks.  Unset fields take their default of 0x00000800, and the check
+// is skipped when TPROXYMode is unset or Disabled because the mark is not used.
+func ValidateTPROXYMark(spec *FelixConfigurationSpec) error {
+	if spec.TPROXYMode == "" || spec.TPROXYMode == "Disabled" {
+		return nil
+	}
+	mark, mask := defaultTPROXYMark, defaultTPROXYMark
+	if spec.TPROXYMark != nil {
+		mark = *spec.TPROXYMark
+	}
+	if spec.TPROXYMarkMask != nil {
+		mask = *spec.TPROXYMarkMask
+	}
+	if mark&^mask != 0 {
+		return fmt.Errorf("tproxyMark %#08x has bits outside tproxyMarkMask %#08x", mark, mask)
+	}
+	return nil
+}
+
+// ValidateFailsafeHostPorts returns an error if any of the FailsafeInboundHostPorts or FailsafeOutboundHostPorts is
+// invalid.
+func ValidateFailsafeHostPorts(spec *FelixConfigurationSpec) error {
+	for _, ports := range []*[]ProtoPort{spec.FailsafeInboundHostPorts, spec.FailsafeOutboundHostPorts} {
+		if ports == nil {
+			continue
+		}
+		for _, p := range *ports {
+			if err := p.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// InterfacePrefixesToString converts a list of interface prefixes into the comma-separated form that Felix uses
+// for its InterfacePrefix configuration parameter.
+func InterfacePrefixesToString(prefixes []string) string {
+	return strings.Join(prefixes, ",")
 }
 
 // New FelixConfiguration creates a new (zeroed) FelixConfiguration struct with the TypeMetadata
@@ -0,0 +1,389 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("InterfacePrefixesToString",
+	func(prefixes []string, expected string) {
+		Expect(InterfacePrefixesToString(prefixes)).To(Equal(expected))
+	},
+	Entry("nil list", nil, ""),
+	Entry("single prefix", []string{"cali"}, "cali"),
+	Entry("multiple prefixes", []string{"cali", "tap", "net"}, "cali,tap,net"),
+)
+
+var _ = DescribeTable("FeatureDetectOverridesFromString",
+	func(input string, expected *FeatureDetectOverrides, expectErr bool) {
+		overrides, err := FeatureDetectOverridesFromString(input)
+		if expectErr {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overrides).To(Equal(expected))
+	},
+	Entry("empty string", "", &FeatureDetectOverrides{}, false),
+	Entry("forced and auto-detected features",
+		"SNATFullyRandom=true,MASQFullyRandom=false,RestoreSupportsLock=",
+		&FeatureDetectOverrides{SNATFullyRandom: boolPtr(true), MASQFullyRandom: boolPtr(false)}, false),
+	Entry("BPF ktime feature", "BPFKTimeGetNSSupported=false", &FeatureDetectOverrides{BPFKTimeGetNSSupported: boolPtr(false)}, false),
+	Entry("unknown feature", "SNATFullyRandm=true", nil, true),
+	Entry("invalid value", "SNATFullyRandom=yes", nil, true),
+	Entry("missing value", "SNATFullyRandom", nil, true),
+)
+
+var _ = DescribeTable("ValidateHealthCheckTimeout",
+	func(timeout *metav1.Duration, expectErr bool) {
+		spec := FelixConfigurationSpec{HealthCheckTimeout: timeout}
+		expectValidationResult(ValidateHealthCheckTimeout(&spec), expectErr)
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("unset", nil, false),
+	Entry("zero disables the timeout", &metav1.Duration{}, false),
+	Entry("positive", &metav1.Duration{Duration: 10 * time.Second}, false),
+	Entry("negative", &metav1.Duration{Duration: -time.Second}, true),
+)
+
+var _ = DescribeTable("ProtoPort.Validate",
+	func(p ProtoPort, expectErr bool) {
+		expectValidationResult(p.Validate(), expectErr)
+	},
+	Entry("TCP port", ProtoPort{Protocol: "tcp", Port: 22}, false),
+	Entry("ICMP type and code", ProtoPort{Protocol: "icmp", ICMPType: intPtr(8), ICMPCode: intPtr(0)}, false),
+	Entry("ICMPv6 type", ProtoPort{Protocol: "ICMPv6", ICMPType: intPtr(128)}, false),
+	Entry("ICMP code with a port", ProtoPort{Protocol: "icmp", Port: 1, ICMPCode: intPtr(0)}, false),
+	Entry("ICMP type on TCP", ProtoPort{Protocol: "tcp", ICMPType: intPtr(8)}, true),
+	Entry("ICMP code on UDP", ProtoPort{Protocol: "udp", ICMPCode: intPtr(0)}, true),
+	Entry("ICMP type with a port", ProtoPort{Protocol: "icmp", Port: 1, ICMPType: intPtr(8)}, true),
+)
+
+var _ = DescribeTable("FelixConfigurationSpec.Validate",
+	func(spec FelixConfigurationSpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("empty spec", FelixConfigurationSpec{}, false),
+	Entry("consistent cross-field settings", FelixConfigurationSpec{
+		FlowLogsDynamicAggregationEnabled: boolPtr(true),
+		ServiceCIDRs:                      &[]string{"10.96.0.0/12", "fd00:10:96::/112"},
+		MaxIpsetSoftLimit:                 intPtr(1000),
+		MaxIpsetHardLimit:                 intPtr(2000),
+		ECMPEnabled:                       boolPtr(true),
+		ECMPHashMode:                      "Layer4",
+		BPFKubeProxyEndpointHealthzPort:   intPtr(10256),
+		FlowLogsS3Enabled:                 boolPtr(true),
+		FlowLogsS3BucketName:              "flow-logs",
+		FlowLogsS3Region:                  "us-west-2",
+		WireguardPersistentKeepAlive:      &metav1.Duration{},
+		TPROXYMode:                        "Enabled",
+		RouteTableRanges:                  &[]RouteTableRange{{Min: 1, Max: 250}},
+	}, false),
+	Entry("static aggregation kind with dynamic aggregation", FelixConfigurationSpec{
+		FlowLogsDynamicAggregationEnabled:     boolPtr(true),
+		FlowLogsFileAggregationKindForAllowed: intPtr(2),
+	}, true),
+	Entry("two IPv4 service CIDRs", FelixConfigurationSpec{ServiceCIDRs: &[]string{"10.96.0.0/12", "10.97.0.0/16"}}, true),
+	Entry("invalid BPF interface log filter", FelixConfigurationSpec{BPFInterfaceLogFilters: &map[string]string{"eth[": "Debug"}}, true),
+	Entry("ipset soft limit above hard limit", FelixConfigurationSpec{MaxIpsetSoftLimit: intPtr(3), MaxIpsetHardLimit: intPtr(2)}, true),
+	Entry("ECMP hash mode without ECMP", FelixConfigurationSpec{ECMPHashMode: "Layer3"}, true),
+	Entry("BPF kube-proxy healthz port on the health port", FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(9099)}, true),
+	Entry("IPSec strict mode without IPSec", FelixConfigurationSpec{IPSecStrictMode: boolPtr(true)}, true),
+	Entry("zero health timeout override", FelixConfigurationSpec{
+		HealthTimeoutOverrides: map[string]*metav1.Duration{"InternalDataplaneMainLoop": {}},
+	}, true),
+	Entry("flow log S3 export without a bucket", FelixConfigurationSpec{FlowLogsS3Enabled: boolPtr(true), FlowLogsS3Region: "us-west-2"}, true),
+	Entry("DNS log S3 export without a bucket", FelixConfigurationSpec{DNSLogsS3Enabled: boolPtr(true), DNSLogsS3Region: "us-west-2"}, true),
+	Entry("L7 log Elasticsearch export without an endpoint", FelixConfigurationSpec{L7LogsElasticsearchEnabled: boolPtr(true)}, true),
+	Entry("negative Wireguard keepalive", FelixConfigurationSpec{WireguardPersistentKeepAlive: &metav1.Duration{Duration: -time.Second}}, true),
+	Entry("TPROXY mark outside its mask", FelixConfigurationSpec{TPROXYMode: "Enabled", TPROXYMark: uint32Ptr(0x1000)}, true),
+	Entry("ICMP type on a TCP failsafe port", FelixConfigurationSpec{
+		FailsafeOutboundHostPorts: &[]ProtoPort{{Protocol: "tcp", Port: 179}, {Protocol: "tcp", ICMPType: intPtr(8)}},
+	}, true),
+	Entry("invalid deprecated route table range", FelixConfigurationSpec{RouteTableRange: &RouteTableRange{Min: 10, Max: 5}}, true),
+	Entry("route table ranges including reserved tables", FelixConfigurationSpec{
+		RouteTableRanges: &[]RouteTableRange{{Min: 1, Max: 254}},
+	}, true),
+)
+
+var _ = DescribeTable("ValidateFlowLogAggregationConsistency",
+	func(dynamic *bool, allowed, denied *int, expectErr bool) {
+		spec := &FelixConfigurationSpec{
+			FlowLogsDynamicAggregationEnabled:     dynamic,
+			FlowLogsFileAggregationKindForAllowed: allowed,
+			FlowLogsFileAggregationKindForDenied:  denied,
+		}
+		expectValidationResult(ValidateFlowLogAggregationConsistency(spec), expectErr)
+	},
+	Entry("dynamic on, static unset", boolPtr(true), nil, nil, false),
+	Entry("dynamic on, allowed kind set", boolPtr(true), intPtr(2), nil, true),
+	Entry("dynamic on, denied kind set", boolPtr(true), nil, intPtr(1), true),
+	Entry("dynamic off, static unset", boolPtr(false), nil, nil, false),
+	Entry("dynamic off, static set", boolPtr(false), intPtr(2), intPtr(1), false),
+	Entry("dynamic unset, static set", nil, intPtr(2), intPtr(1), false),
+)
+
+var _ = DescribeTable("ValidateServiceCIDRs",
+	func(cidrs *[]string, expectErr bool) {
+		spec := &FelixConfigurationSpec{ServiceCIDRs: cidrs}
+		expectValidationResult(ValidateServiceCIDRs(spec), expectErr)
+	},
+	Entry("unset", nil, false),
+	Entry("empty", &[]string{}, false),
+	Entry("single IPv4", &[]string{"10.96.0.0/12"}, false),
+	Entry("single IPv6", &[]string{"fd00:10:96::/112"}, false),
+	Entry("dual-stack", &[]string{"10.96.0.0/12", "fd00:10:96::/112"}, false),
+	Entry("dual-stack, IPv6 first", &[]string{"fd00:10:96::/112", "10.96.0.0/12"}, false),
+	Entry("two IPv4", &[]string{"10.96.0.0/12", "10.112.0.0/12"}, true),
+	Entry("two IPv6", &[]string{"fd00:10:96::/112", "fd00:10:97::/112"}, true),
+	Entry("three CIDRs", &[]string{"10.96.0.0/12", "fd00:10:96::/112", "10.112.0.0/12"}, true),
+	Entry("invalid CIDR", &[]string{"10.96.0.0/33"}, true),
+)
+
+var _ = DescribeTable("ValidateBPFInterfaceLogFilters",
+	func(filters *map[string]string, expectErr bool) {
+		spec := &FelixConfigurationSpec{BPFInterfaceLogFilters: filters}
+		expectValidationResult(ValidateBPFInterfaceLogFilters(spec), expectErr)
+	},
+	Entry("unset", nil, false),
+	Entry("empty", &map[string]string{}, false),
+	Entry("valid patterns and levels", &map[string]string{"^eth.*": "Debug", "cali.*": "Info", "lo": "Off"}, false),
+	Entry("invalid pattern", &map[string]string{"eth[": "Debug"}, true),
+	Entry("invalid level", &map[string]string{"eth0": "Trace"}, true),
+	Entry("lower case level", &map[string]string{"eth0": "debug"}, true),
+)
+
+var _ = DescribeTable("ValidateMaxIpsetLimits",
+	func(soft, hard *int, expectErr bool) {
+		spec := &FelixConfigurationSpec{MaxIpsetSoftLimit: soft, MaxIpsetHardLimit: hard}
+		expectValidationResult(ValidateMaxIpsetLimits(spec), expectErr)
+	},
+	Entry("both unset", nil, nil, false),
+	Entry("only soft limit set", intPtr(1000), nil, false),
+	Entry("only hard limit set", nil, intPtr(1000), false),
+	Entry("soft limit below hard limit", intPtr(1000), intPtr(2000), false),
+	Entry("soft limit equal to hard limit", intPtr(2000), intPtr(2000), false),
+	Entry("soft limit above hard limit", intPtr(2001), intPtr(2000), true),
+)
+
+var _ = DescribeTable("ValidateECMPHashMode",
+	func(enabled *bool, mode string, expectErr bool) {
+		spec := &FelixConfigurationSpec{ECMPEnabled: enabled, ECMPHashMode: mode}
+		expectValidationResult(ValidateECMPHashMode(spec), expectErr)
+	},
+	Entry("neither set", nil, "", false),
+	Entry("ECMP enabled without a hash mode", boolPtr(true), "", false),
+	Entry("ECMP enabled with a hash mode", boolPtr(true), ECMPHashModeLayer4, false),
+	Entry("hash mode set with ECMP unset", nil, ECMPHashModeLayer4, true),
+	Entry("hash mode set with ECMP disabled", boolPtr(false), ECMPHashModeLayer3AndPort, true),
+)
+
+var _ = DescribeTable("ValidateBPFKubeProxyEndpointHealthzPort",
+	func(spec FelixConfigurationSpec, expectErr bool) {
+		expectValidationResult(ValidateBPFKubeProxyEndpointHealthzPort(&spec), expectErr)
+	},
+	Entry("unset", FelixConfigurationSpec{HealthPort: intPtr(9099)}, false),
+	Entry("distinct from the default health port", FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(10256)}, false),
+	Entry("same as the default health port", FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(9099)}, true),
+	Entry("same as an explicit health port",
+		FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(10256), HealthPort: intPtr(10256)}, true),
+	Entry("default health port when health port is moved",
+		FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(9099), HealthPort: intPtr(9100)}, false),
+	Entry("same as the Prometheus metrics port",
+		FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(9091), PrometheusMetricsPort: intPtr(9091)}, true),
+	Entry("same as the Prometheus reporter port",
+		FelixConfigurationSpec{BPFKubeProxyEndpointHealthzPort: intPtr(9092), PrometheusReporterPort: intPtr(9092)}, true),
+)
+
+var _ = DescribeTable("ValidateIPSecStrictMode",
+	func(strict *bool, mode string, expectErr bool) {
+		spec := &FelixConfigurationSpec{IPSecStrictMode: strict, IPSecMode: mode}
+		expectValidationResult(ValidateIPSecStrictMode(spec), expectErr)
+	},
+	Entry("strict mode unset", nil, "", false),
+	Entry("strict mode disabled without IPSec", boolPtr(false), "", false),
+	Entry("strict mode enabled with IPSec", boolPtr(true), "PSK", false),
+	Entry("strict mode enabled without IPSec", boolPtr(true), "", true),
+)
+
+var _ = DescribeTable("ValidateHealthTimeoutOverrides",
+	func(overrides map[string]*metav1.Duration, expectErr bool) {
+		spec := &FelixConfigurationSpec{HealthTimeoutOverrides: overrides}
+		expectValidationResult(ValidateHealthTimeoutOverrides(spec), expectErr)
+	},
+	Entry("unset", nil, false),
+	Entry("positive timeouts", map[string]*metav1.Duration{
+		"dataplane":  {Duration: 90 * time.Second},
+		"policySync": {Duration: time.Minute},
+	}, false),
+	Entry("zero timeout", map[string]*metav1.Duration{"dataplane": {}}, true),
+	Entry("negative timeout", map[string]*metav1.Duration{"dataplane": {Duration: -time.Second}}, true),
+	Entry("nil timeout", map[string]*metav1.Duration{"dataplane": nil}, true),
+)
+
+var _ = DescribeTable("ValidateFlowLogsS3",
+	func(enabled *bool, bucket, region string, expectErr bool) {
+		spec := &FelixConfigurationSpec{FlowLogsS3Enabled: enabled, FlowLogsS3BucketName: bucket, FlowLogsS3Region: region}
+		expectValidationResult(ValidateFlowLogsS3(spec), expectErr)
+	},
+	Entry("unset", nil, "", "", false),
+	Entry("disabled without a bucket", boolPtr(false), "", "", false),
+	Entry("enabled with bucket and region", boolPtr(true), "flow-logs", "us-west-2", false),
+	Entry("enabled without a bucket", boolPtr(true), "", "us-west-2", true),
+	Entry("enabled without a region", boolPtr(true), "flow-logs", "", true),
+)
+
+var _ = DescribeTable("ValidateDNSLogsS3",
+	func(enabled *bool, bucket, region string, expectErr bool) {
+		spec := &FelixConfigurationSpec{DNSLogsS3Enabled: enabled, DNSLogsS3BucketName: bucket, DNSLogsS3Region: region}
+		expectValidationResult(ValidateDNSLogsS3(spec), expectErr)
+	},
+	Entry("unset", nil, "", "", false),
+	Entry("disabled without a bucket", boolPtr(false), "", "", false),
+	Entry("enabled with bucket and region", boolPtr(true), "dns-logs", "us-west-2", false),
+	Entry("enabled without a bucket", boolPtr(true), "", "us-west-2", true),
+	Entry("enabled without a region", boolPtr(true), "dns-logs", "", true),
+)
+
+var _ = DescribeTable("ValidateL7LogsExport",
+	func(spec FelixConfigurationSpec, expectErr bool) {
+		expectValidationResult(ValidateL7LogsExport(&spec), expectErr)
+	},
+	Entry("nothing enabled", FelixConfigurationSpec{}, false),
+	Entry("S3 disabled without a bucket", FelixConfigurationSpec{L7LogsS3Enabled: boolPtr(false)}, false),
+	Entry("S3 enabled with bucket and region", FelixConfigurationSpec{
+		L7LogsS3Enabled: boolPtr(true), L7LogsS3BucketName: "l7-logs", L7LogsS3Region: "us-west-2",
+	}, false),
+	Entry("S3 enabled without a bucket", FelixConfigurationSpec{
+		L7LogsS3Enabled: boolPtr(true), L7LogsS3Region: "us-west-2",
+	}, true),
+	Entry("S3 enabled without a region", FelixConfigurationSpec{
+		L7LogsS3Enabled: boolPtr(true), L7LogsS3BucketName: "l7-logs",
+	}, true),
+	Entry("Elasticsearch enabled with an endpoint", FelixConfigurationSpec{
+		L7LogsElasticsearchEnabled: boolPtr(true), L7LogsElasticsearchEndpoint: "https://es.example.com:9200",
+	}, false),
+	Entry("Elasticsearch enabled without an endpoint", FelixConfigurationSpec{L7LogsElasticsearchEnabled: boolPtr(true)}, true),
+	Entry("Elasticsearch disabled without an endpoint", FelixConfigurationSpec{L7LogsElasticsearchEnabled: boolPtr(false)}, false),
+)
+
+var _ = DescribeTable("ValidateWireguardPersistentKeepAlive",
+	func(keepAlive *metav1.Duration, expectErr bool) {
+		spec := &FelixConfigurationSpec{WireguardPersistentKeepAlive: keepAlive}
+		expectValidationResult(ValidateWireguardPersistentKeepAlive(spec), expectErr)
+	},
+	Entry("unset", nil, false),
+	Entry("zero disables keepalives", &metav1.Duration{}, false),
+	Entry("positive", &metav1.Duration{Duration: 25 * time.Second}, false),
+	Entry("negative", &metav1.Duration{Duration: -time.Second}, true),
+)
+
+var _ = DescribeTable("ValidateTPROXYMark",
+	func(mode string, mark, mask *uint32, expectErr bool) {
+		spec := &FelixConfigurationSpec{TPROXYMode: mode, TPROXYMark: mark, TPROXYMarkMask: mask}
+		expectValidationResult(ValidateTPROXYMark(spec), expectErr)
+	},
+	Entry("mode unset, mark outside the mask", "", uint32Ptr(0x1000), uint32Ptr(0x800), false),
+	Entry("disabled, mark outside the mask", "Disabled", uint32Ptr(0x1000), uint32Ptr(0x800), false),
+	Entry("enabled, defaults", "Enabled", nil, nil, false),
+	Entry("enabled, mark equal to mask", "Enabled", uint32Ptr(0x800), uint32Ptr(0x800), false),
+	Entry("enabled, mark within a wider mask", "Enabled", uint32Ptr(0x800), uint32Ptr(0xf00), false),
+	Entry("enabled, zero mark", "Enabled", uint32Ptr(0), uint32Ptr(0x800), false),
+	Entry("enabled, mark outside the mask", "Enabled", uint32Ptr(0x1000), uint32Ptr(0x800), true),
+	Entry("enabled, mark partially outside the mask", "EnabledAllServices", uint32Ptr(0x1800), uint32Ptr(0x800), true),
+	Entry("enabled, mark outside the default mask", "Enabled", uint32Ptr(0x1000), nil, true),
+	Entry("enabled, default mark outside the mask", "Enabled", nil, uint32Ptr(0x1000), true),
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+var _ = Describe("FelixConfigurationSpec DeepCopy", func() {
+	It("should deep copy HealthTimeoutOverrides", func() {
+		spec := FelixConfigurationSpec{
+			HealthTimeoutOverrides: map[string]*metav1.Duration{
+				"dataplane": {Duration: 90 * time.Second},
+			},
+		}
+
+		copied := spec.DeepCopy()
+		Expect(copied.HealthTimeoutOverrides).To(Equal(spec.HealthTimeoutOverrides))
+
+		copied.HealthTimeoutOverrides["dataplane"].Duration = time.Second
+		copied.HealthTimeoutOverrides["policySync"] = &metav1.Duration{Duration: time.Minute}
+		Expect(spec.HealthTimeoutOverrides).To(HaveLen(1))
+		Expect(spec.HealthTimeoutOverrides["dataplane"].Duration).To(Equal(90 * time.Second))
+	})
+})
+
+var _ = Describe("FelixConfigurationSpec JSON", func() {
+	It("should round-trip FlowLogsEnableDNSPolicyViolation", func() {
+		disabled := false
+		spec := FelixConfigurationSpec{FlowLogsEnableDNSPolicyViolation: &disabled}
+
+		b, err := json.Marshal(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"flowLogsEnableDNSPolicyViolation":false`))
+
+		var out FelixConfigurationSpec
+		Expect(json.Unmarshal(b, &out)).To(Succeed())
+		Expect(out).To(Equal(spec))
+	})
+
+	It("should preserve a zero HealthCheckTimeout", func() {
+		spec := FelixConfigurationSpec{HealthCheckTimeout: &metav1.Duration{}}
+
+		b, err := json.Marshal(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"healthCheckTimeout":"0s"`))
+
+		var out FelixConfigurationSpec
+		Expect(json.Unmarshal(b, &out)).To(Succeed())
+		Expect(out.HealthCheckTimeout).NotTo(BeNil())
+		Expect(out.HealthCheckTimeout.Duration).To(BeZero())
+	})
+
+	It("should preserve a zero FlowLogsExportBatchTimeout", func() {
+		spec := FelixConfigurationSpec{FlowLogsExportBatchTimeout: &metav1.Duration{}}
+
+		b, err := json.Marshal(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"flowLogsExportBatchTimeout":"0s"`))
+
+		var out FelixConfigurationSpec
+		Expect(json.Unmarshal(b, &out)).To(Succeed())
+		Expect(out.FlowLogsExportBatchTimeout).NotTo(BeNil())
+		Expect(out.FlowLogsExportBatchTimeout.Duration).To(BeZero())
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindGeneratedReport     = "GeneratedReport"
+	KindGeneratedReportList = "GeneratedReportList"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GeneratedReport describes a report artifact produced by a completed report job.
+type GeneratedReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the GeneratedReport.
+	Spec GeneratedReportSpec `json:"spec,omitempty"`
+}
+
+// GeneratedReportSpec contains the values of the GeneratedReport.
+type GeneratedReportSpec struct {
+	// The name of the GlobalReport that the artifact was generated for.
+	ReportName string `json:"reportName" validate:"name,required"`
+
+	// The name of the GlobalReportType used to render the artifact.
+	ReportTypeName string `json:"reportTypeName" validate:"name,required"`
+
+	// The start time of the period covered by the report.
+	StartTime metav1.Time `json:"startTime"`
+
+	// The end time of the period covered by the report.
+	EndTime metav1.Time `json:"endTime"`
+
+	// The time at which generation of the report completed.
+	GenerationTime *metav1.Time `json:"generationTime,omitempty"`
+
+	// The URL from which the report artifact can be downloaded.
+	DownloadURL string `json:"downloadURL" validate:"required,url"`
+
+	// The hex-encoded SHA-256 hash of the report artifact, used to verify its integrity after download.
+	// +kubebuilder:validation:Pattern=`^[0-9a-f]{64}$`
+	Hash string `json:"hash,omitempty" validate:"omitempty,hexadecimal,len=64"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GeneratedReportList contains a list of GeneratedReport resources.
+type GeneratedReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []GeneratedReport `json:"items"`
+}
+
+// NewGeneratedReport creates a new (zeroed) GeneratedReport struct with the TypeMetadata initialised to the current
+// version.
+func NewGeneratedReport() *GeneratedReport {
+	return &GeneratedReport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindGeneratedReport,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewGeneratedReportList creates a new (zeroed) GeneratedReportList struct with the TypeMetadata initialised to the
+// current version.
+func NewGeneratedReportList() *GeneratedReportList {
+	return &GeneratedReportList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindGeneratedReportList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
@@ -121,6 +121,31 @@ type GlobalNetworkPolicySpec struct {
 
 	// NamespaceSelector is an optional field for an expression used to select a pod based on namespaces.
 	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"selector"`
+
+	// EgressGateway is an optional field that directs traffic matched by this policy through the
+	// egress gateways it selects.  It is only honoured when Felix's EgressIPSupport is enabled;
+	// this is not enforced at admission time because the Felix configuration is a separate resource.
+	// +optional
+	EgressGateway *EgressGatewayRef `json:"egressGateway,omitempty" validate:"omitempty"`
+
+	// EndpointTypes limits the policy to the given kinds of endpoint.  If omitted, the policy applies
+	// to all endpoint types that its selector matches.
+	// +optional
+	EndpointTypes []PolicyEndpointType `json:"endpointTypes,omitempty" validate:"omitempty,dive,oneof=WorkloadEndpoint HostEndpoint"`
+
+	// StagedMode indicates that the policy is evaluated and its decisions are logged, but not
+	// enforced.  This allows the effect of a policy change to be observed before it takes effect.
+	// [Default: false]
+	// +optional
+	StagedMode *bool `json:"stagedMode,omitempty"`
+}
+
+// EgressGatewayRef identifies a set of egress gateways.
+type EgressGatewayRef struct {
+	// Selector is an expression used to pick out the egress gateway pods.
+	Selector string `json:"selector,omitempty" validate:"omitempty,selector"`
+	// NamespaceSelector is an expression used to pick out the namespaces of the egress gateway pods.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"omitempty,selector"`
 }
 
 // NewGlobalNetworkPolicy creates a new (zeroed) GlobalNetworkPolicy struct with the TypeMetadata initialised to the current
@@ -62,6 +62,24 @@ type ReportTypeSpec struct {
 
 	// Whether to include the full cis benchmark test results in the report.
 	IncludeCISBenchmarkData bool `json:"includeCISBenchmarkData,omitempty"`
+
+	// What resource inventory data should be included in the report. If not specified, the report will contain no
+	// inventory data.
+	InventoryConfig *ReportInventoryConfig `json:"inventoryConfig,omitempty" validate:"omitempty"`
+
+	// The default set of endpoints that are in-scope for reports of this type. This may be further restricted by the
+	// EndpointsSelection of the Report.
+	EndpointsSelection *EndpointsSelection `json:"endpointsSelection,omitempty" validate:"omitempty"`
+
+	// Whether reports of this type are displayed by the UI. [Default: true]
+	UIEnabled *bool `json:"uiEnabled,omitempty"`
+}
+
+// ReportInventoryConfig defines which resources are included in the inventory section of a report.
+type ReportInventoryConfig struct {
+	// Resources lists the resources that will be included in the inventory in the ReportData.  Blank fields in the
+	// listed AuditResource structs are treated as wildcards.
+	Resources []AuditResource `json:"resources,omitempty" validate:"omitempty"`
 }
 
 // ReportTemplate defines a template used to render a report into downloadable or UI compatible format.
@@ -66,14 +66,51 @@ type GlobalNetworkSetSync struct {
 }
 
 type Pull struct {
-	Period string    `json:"period,omitempty"`
-	HTTP   *HTTPPull `json:"http" validate:"required"`
+	// Deprecated: use PullInterval instead.  Ignored if PullInterval is set.
+	Period string `json:"period,omitempty"`
+	// PullInterval is how often the feed is refreshed.  [Default: 24h]
+	// +optional
+	PullInterval *metav1.Duration `json:"pullInterval,omitempty" validate:"omitempty"`
+	HTTP         *HTTPPull        `json:"http" validate:"required"`
 }
 
 type HTTPPull struct {
 	Format  ThreatFeedFormat `json:"format,omitempty" validate:"omitempty"`
 	URL     string           `json:"url" validate:"required,url"`
 	Headers []HTTPHeader     `json:"headers,omitempty" validate:"dive"`
+	// FetchTimeout is the timeout for each HTTP request made to fetch the feed.
+	// +optional
+	FetchTimeout *metav1.Duration `json:"fetchTimeout,omitempty" validate:"omitempty"`
+	// Auth configures how requests made to fetch the feed are authenticated.  If omitted, requests
+	// are not authenticated beyond any credentials supplied in Headers.
+	// +optional
+	Auth *GlobalThreatFeedAuth `json:"auth,omitempty" validate:"omitempty"`
+}
+
+type ThreatFeedAuthType string
+
+const (
+	ThreatFeedAuthTypeNone   ThreatFeedAuthType = "None"
+	ThreatFeedAuthTypeBasic  ThreatFeedAuthType = "Basic"
+	ThreatFeedAuthTypeBearer ThreatFeedAuthType = "Bearer"
+	ThreatFeedAuthTypeAPIKey ThreatFeedAuthType = "APIKey"
+)
+
+// GlobalThreatFeedAuth configures the authentication used when fetching a threat feed.
+type GlobalThreatFeedAuth struct {
+	// +kubebuilder:validation:Enum=None;Basic;Bearer;APIKey
+	// Type is the kind of authentication to use, one of "None", "Basic", "Bearer" or "APIKey".
+	Type ThreatFeedAuthType `json:"type" validate:"oneof=None Basic Bearer APIKey"`
+	// SecretRef references the secret that holds the credentials.  For "Basic" auth the secret
+	// must contain "username" and "password" keys; for "Bearer" and "APIKey" auth it must contain
+	// a "token" key.
+	// +optional
+	SecretRef *k8sv1.LocalObjectReference `json:"secretRef,omitempty"`
+	// HeaderName is the name of the header that carries the key when Type is "APIKey".  It must be a
+	// valid HTTP header field name, that is an RFC 7230 token.
+	// +kubebuilder:validation:Pattern=`^[-!#$%&'*+.^_\x60|~0-9A-Za-z]+$`
+	// +optional
+	HeaderName string `json:"headerName,omitempty" validate:"omitempty,httpHeaderName"`
 }
 
 type ThreatFeedFormat struct {
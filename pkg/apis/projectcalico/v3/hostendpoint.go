@@ -15,6 +15,8 @@
 package v3
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/tigera/api/pkg/lib/numorstring"
@@ -75,13 +77,21 @@ type HostEndpointSpec struct {
 	// 	endpoints, the ExpectedIPs field is used for that purpose. (If only the interface
 	// 	name is specified, Calico does not learn the IPs of the interface for use in match
 	// 	criteria.)
-	ExpectedIPs []string `json:"expectedIPs,omitempty" validate:"omitempty,dive,ip"`
+	ExpectedIPs []string `json:"expectedIPs,omitempty" validate:"omitempty,expectedIPs"`
 	// A list of identifiers of security Profile objects that apply to this endpoint. Each
 	// profile is applied in the order that they appear in this list.  Profile rules are applied
 	// after the selector-based security policy.
 	Profiles []string `json:"profiles,omitempty" validate:"omitempty,dive,name"`
 	// Ports contains the endpoint's named ports, which may be referenced in security policy rules.
 	Ports []EndpointPort `json:"ports,omitempty" validate:"dive"`
+	// A list of policies, each identified as either "name" or "namespace/name", to apply to
+	// traffic through this endpoint before DNAT is performed.
+	// +optional
+	PreDNATPolicyNames []string `json:"preDNATPolicyNames,omitempty" validate:"omitempty,dive,policyName"`
+	// Indicates whether the policy applied to this endpoint also applies to traffic that is
+	// forwarded through the host.  Defaults to false.
+	// +optional
+	ApplyOnForward *bool `json:"applyOnForward,omitempty"`
 }
 
 type EndpointPort struct {
@@ -100,3 +110,12 @@ func NewHostEndpoint() *HostEndpoint {
 		},
 	}
 }
+
+// Validate returns an error if any of the HostEndpoint's ExpectedIPs is not a plain IP address, as checked by
+// ValidateExpectedIPs.
+func (h *HostEndpoint) Validate() error {
+	if err := ValidateExpectedIPs(h.Spec.ExpectedIPs); err != nil {
+		return fmt.Errorf("host endpoint %s: %v", h.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("HostEndpoint.Validate",
+	func(expectedIPs []string, expectErr bool) {
+		h := HostEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "hep"},
+			Spec:       HostEndpointSpec{ExpectedIPs: expectedIPs},
+		}
+		if expectErr {
+			Expect(h.Validate()).To(HaveOccurred())
+		} else {
+			Expect(h.Validate()).NotTo(HaveOccurred())
+		}
+	},
+	Entry("no expected IPs", nil, false),
+	Entry("IPv4 address", []string{"10.0.0.1"}, false),
+	Entry("IPv6 address", []string{"fd00::1"}, false),
+	Entry("IPv4 and IPv6 addresses", []string{"10.0.0.1", "fd00::1"}, false),
+	Entry("IPv4 CIDR", []string{"10.0.0.0/24"}, true),
+	Entry("IPv6 CIDR", []string{"fd00::/64"}, true),
+	Entry("empty string", []string{""}, true),
+	Entry("hostname", []string{"node1"}, true),
+	Entry("valid address followed by a CIDR", []string{"10.0.0.1", "10.0.0.0/24"}, true),
+)
@@ -15,6 +15,9 @@
 package v3
 
 import (
+	"fmt"
+	"net"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -68,9 +71,49 @@ type IPPoolSpec struct {
 	// The block size to use for IP address assignments from this pool. Defaults to 26 for IPv4 and 112 for IPv6.
 	BlockSize int `json:"blockSize,omitempty"`
 
-	// Allows IPPool to allocate for a specific node by label selector.
+	// Allows IPPool to allocate for a specific node by label selector.  When set, Calico IPAM only
+	// allocates addresses from this pool to nodes that match the selector, for example to give GPU
+	// nodes their own CIDR.
+	// +optional
 	NodeSelector string `json:"nodeSelector,omitempty" validate:"omitempty,selector"`
 
+	// When disableNewAllocations is true, Calico IPAM keeps the existing allocations from this pool
+	// but does not make new ones.  Unlike disabled, this allows workloads to be migrated gradually
+	// to another pool.  [Default: false]
+	// +optional
+	DisableNewAllocations *bool `json:"disableNewAllocations,omitempty"`
+
+	// When strictAffinity is true, Calico IPAM will not borrow addresses from blocks in this pool
+	// that are affine to other nodes, even when the blocks affine to a node are exhausted.
+	// +optional
+	StrictAffinity bool `json:"strictAffinity,omitempty"`
+
+	// The maximum time that an address allocation from this pool may block before it fails.
+	// If not specified, the allocation is retried until it succeeds or the request is cancelled.
+	// +optional
+	AllocationTimeout *metav1.Duration `json:"allocationTimeout,omitempty"`
+
+	// A list of CIDRs within the pool that Calico IPAM will not use for dynamic address allocation.
+	// +optional
+	ReservedBlocks []string `json:"reservedBlocks,omitempty" validate:"omitempty,dive,cidr"`
+
+	// The names of the BGPFilter resources that are applied to the BGP advertisement of this pool's
+	// routes.  When more than one filter is referenced, they are applied in the order listed, and the
+	// first rule that matches a route determines the action taken.
+	// +optional
+	BGPFilterRefs []string `json:"bgpFilterRefs,omitempty" validate:"omitempty,dive,name"`
+
+	// AllowedUses controls what the IP pool will be used for.  If not specified, the pool may be used for
+	// workload and tunnel addresses.
+	// +optional
+	AllowedUses []IPPoolAllowedUse `json:"allowedUses,omitempty" validate:"omitempty,dive,oneof=Workload Tunnel LoadBalancer"`
+
+	// Priority ranks this pool against the other pools that match an allocation request.  Pools with a
+	// lower priority value are used first.  If not specified, the pool is used after those that have one.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Priority *int `json:"priority,omitempty" validate:"omitempty,gte=0"`
+
 	// Deprecated: this field is only used for APIv1 backwards compatibility.
 	// Setting this field is not allowed, this field is for internal use only.
 	IPIP *IPIPConfiguration `json:"ipip,omitempty" validate:"omitempty,mustBeNil"`
@@ -80,6 +123,16 @@ type IPPoolSpec struct {
 	NATOutgoingV1 bool `json:"nat-outgoing,omitempty" validate:"omitempty,mustBeFalse"`
 }
 
+// +kubebuilder:validation:Enum=Workload;Tunnel;LoadBalancer
+type IPPoolAllowedUse string
+
+const (
+	IPPoolAllowedUseWorkload     IPPoolAllowedUse = "Workload"
+	IPPoolAllowedUseTunnel       IPPoolAllowedUse = "Tunnel"
+	IPPoolAllowedUseLoadBalancer IPPoolAllowedUse = "LoadBalancer"
+)
+
+// +kubebuilder:validation:Enum=Never;Always;CrossSubnet
 type VXLANMode string
 
 const (
@@ -88,6 +141,7 @@ const (
 	VXLANModeCrossSubnet           = "CrossSubnet"
 )
 
+// +kubebuilder:validation:Enum=Never;Always;CrossSubnet
 type IPIPMode string
 
 const (
@@ -121,6 +175,37 @@ type IPIPConfiguration struct {
 	Mode EncapMode `json:"mode,omitempty" validate:"ipIpMode"`
 }
 
+// VXLANModeFromEnabled converts a boolean VXLAN setting to the equivalent VXLANMode: true becomes
+// "Always" and false becomes "Never".
+func VXLANModeFromEnabled(enabled bool) VXLANMode {
+	if enabled {
+		return VXLANModeAlways
+	}
+	return VXLANModeNever
+}
+
+// IPIPModeFromEnabled converts a boolean IPIP setting to the equivalent IPIPMode: true becomes
+// "Always" and false becomes "Never".
+func IPIPModeFromEnabled(enabled bool) IPIPMode {
+	if enabled {
+		return IPIPModeAlways
+	}
+	return IPIPModeNever
+}
+
+// IPIPModeFromV1 converts the APIv1 IPIP configuration to the equivalent IPIPMode.  A nil or disabled
+// configuration becomes "Never", and an enabled one becomes "CrossSubnet" or "Always" according to its
+// mode, which defaults to "always".
+func IPIPModeFromV1(ipip *IPIPConfiguration) IPIPMode {
+	if ipip == nil || !ipip.Enabled {
+		return IPIPModeNever
+	}
+	if ipip.Mode == CrossSubnet {
+		return IPIPModeCrossSubnet
+	}
+	return IPIPModeAlways
+}
+
 // NewIPPool creates a new (zeroed) IPPool struct with the TypeMetadata initialised to the current
 // version.
 func NewIPPool() *IPPool {
@@ -131,3 +216,34 @@ func NewIPPool() *IPPool {
 		},
 	}
 }
+
+// Validate returns an error if the pool's CIDR is invalid or overlaps the CIDR of any of the existing pools,
+// as described for ValidateIPPoolNoOverlap.
+func (p *IPPool) Validate(existing []IPPool) error {
+	return ValidateIPPoolNoOverlap(p, existing)
+}
+
+// ValidateIPPoolNoOverlap returns an error if the CIDR of pool overlaps the CIDR of any of the
+// existingPools.  An existing pool with the same name as pool is skipped, so that the check can be
+// applied to updates as well as creates.
+func ValidateIPPoolNoOverlap(pool *IPPool, existingPools []IPPool) error {
+	_, cidr, err := net.ParseCIDR(pool.Spec.CIDR)
+	if err != nil {
+		return fmt.Errorf("IPPool(%s) has invalid CIDR %q: %v", pool.Name, pool.Spec.CIDR, err)
+	}
+	for _, existing := range existingPools {
+		if existing.Name == pool.Name {
+			continue
+		}
+		_, existingCIDR, err := net.ParseCIDR(existing.Spec.CIDR)
+		if err != nil {
+			return fmt.Errorf("IPPool(%s) has invalid CIDR %q: %v", existing.Name, existing.Spec.CIDR, err)
+		}
+		// Two CIDRs overlap exactly when one of them contains the network address of the other.
+		if cidr.Contains(existingCIDR.IP) || existingCIDR.Contains(cidr.IP) {
+			return fmt.Errorf("IPPool(%s) CIDR %s overlaps with IPPool(%s) CIDR %s",
+				pool.Name, pool.Spec.CIDR, existing.Name, existing.Spec.CIDR)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+func ipPool(name, cidr string) IPPool {
+	return IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       IPPoolSpec{CIDR: cidr},
+	}
+}
+
+var _ = DescribeTable("ValidateIPPoolNoOverlap",
+	func(cidr string, existing []string, expectErr bool) {
+		var existingPools []IPPool
+		for i, c := range existing {
+			existingPools = append(existingPools, ipPool(string(rune('a'+i)), c))
+		}
+		pool := ipPool("new", cidr)
+		err := ValidateIPPoolNoOverlap(&pool, existingPools)
+		if expectErr {
+			Expect(err).To(HaveOccurred())
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+	Entry("no existing pools", "10.0.0.0/16", nil, false),
+	Entry("disjoint pools", "10.0.0.0/16", []string{"192.168.0.0/16", "172.16.0.0/12"}, false),
+	Entry("adjacent pools", "10.0.1.0/24", []string{"10.0.0.0/24", "10.0.2.0/24"}, false),
+	Entry("exact duplicate", "10.0.0.0/16", []string{"10.0.0.0/16"}, true),
+	Entry("new pool inside an existing pool", "10.0.1.0/24", []string{"10.0.0.0/16"}, true),
+	Entry("new pool containing an existing pool", "10.0.0.0/8", []string{"10.1.0.0/16"}, true),
+	Entry("equivalent CIDRs with host bits set", "10.0.0.1/16", []string{"10.0.255.0/16"}, true),
+	Entry("host route inside an existing pool", "10.0.0.5/32", []string{"10.0.0.0/24"}, true),
+	Entry("host route outside an existing pool", "10.0.1.5/32", []string{"10.0.0.0/24"}, false),
+	Entry("adjacent host routes", "10.0.0.5/32", []string{"10.0.0.4/32", "10.0.0.6/32"}, false),
+	Entry("overlapping IPv6 pools", "fd00:0:0:1::/64", []string{"fd00::/48"}, true),
+	Entry("adjacent IPv6 pools", "fd00:0:0:1::/64", []string{"fd00::/64"}, false),
+	Entry("IPv4 and IPv6 pools", "10.0.0.0/8", []string{"fd00::/48"}, false),
+	Entry("invalid CIDR", "10.0.0.0/33", nil, true),
+	Entry("invalid existing CIDR", "10.0.0.0/16", []string{"not-a-cidr"}, true),
+)
+
+var _ = DescribeTable("ValidateIPPoolNoOverlap on update",
+	func(cidr string, expectErr bool) {
+		pool := ipPool("pool", cidr)
+		err := ValidateIPPoolNoOverlap(&pool, []IPPool{ipPool("pool", "10.0.0.0/16"), ipPool("other", "192.168.0.0/16")})
+		if expectErr {
+			Expect(err).To(HaveOccurred())
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+	Entry("pool is not compared with its own previous version", "10.0.0.0/8", false),
+	Entry("pool is still compared with other pools", "192.168.1.0/24", true),
+)
+
+var _ = DescribeTable("IPPool.Validate",
+	func(pool IPPool, expectErr bool) {
+		existing := []IPPool{ipPool("default", "10.0.0.0/16"), ipPool("v6", "fd00::/48")}
+		expectValidationResult(pool.Validate(existing), expectErr)
+	},
+	Entry("disjoint pool", ipPool("new", "192.168.0.0/16"), false),
+	Entry("update of an existing pool", ipPool("default", "10.0.0.0/15"), false),
+	Entry("overlapping pool", ipPool("new", "10.0.128.0/17"), true),
+	Entry("invalid CIDR", ipPool("new", "not-a-cidr"), true),
+)
+
+var _ = DescribeTable("VXLANModeFromEnabled",
+	func(enabled bool, expected VXLANMode) {
+		Expect(VXLANModeFromEnabled(enabled)).To(Equal(expected))
+	},
+	Entry("enabled", true, VXLANMode(VXLANModeAlways)),
+	Entry("disabled", false, VXLANModeNever),
+)
+
+var _ = DescribeTable("IPIPModeFromEnabled",
+	func(enabled bool, expected IPIPMode) {
+		Expect(IPIPModeFromEnabled(enabled)).To(Equal(expected))
+	},
+	Entry("enabled", true, IPIPMode(IPIPModeAlways)),
+	Entry("disabled", false, IPIPModeNever),
+)
+
+var _ = DescribeTable("IPIPModeFromV1",
+	func(ipip *IPIPConfiguration, expected IPIPMode) {
+		Expect(IPIPModeFromV1(ipip)).To(Equal(expected))
+	},
+	Entry("unset", nil, IPIPModeNever),
+	Entry("disabled", &IPIPConfiguration{Enabled: false, Mode: Always}, IPIPModeNever),
+	Entry("enabled, default mode", &IPIPConfiguration{Enabled: true}, IPIPMode(IPIPModeAlways)),
+	Entry("enabled, always", &IPIPConfiguration{Enabled: true, Mode: Always}, IPIPMode(IPIPModeAlways)),
+	Entry("enabled, cross-subnet", &IPIPConfiguration{Enabled: true, Mode: CrossSubnet}, IPIPMode(IPIPModeCrossSubnet)),
+)
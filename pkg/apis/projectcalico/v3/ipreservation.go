@@ -0,0 +1,66 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindIPReservation     = "IPReservation"
+	KindIPReservationList = "IPReservationList"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPReservation allows certain IP addresses to be reserved (i.e. prevented from being allocated) by Calico
+// IPAM.  Reservations only apply to addresses within Calico IP pools.
+type IPReservation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the IPReservation.
+	Spec IPReservationSpec `json:"spec,omitempty"`
+}
+
+// IPReservationSpec contains the specification for an IPReservation resource.
+type IPReservationSpec struct {
+	// ReservedCIDRs is a list of CIDRs and/or IP addresses that Calico IPAM will exclude from new allocations.
+	ReservedCIDRs []string `json:"reservedCIDRs,omitempty" validate:"omitempty,dive,cidr"`
+
+	// Reason is a free-form description of why the addresses are reserved.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPReservationList contains a list of IPReservation resources.
+type IPReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []IPReservation `json:"items"`
+}
+
+// NewIPReservation creates a new (zeroed) IPReservation struct with the TypeMetadata
+// initialized to the current version.
+func NewIPReservation() *IPReservation {
+	return &IPReservation{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindIPReservation,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewIPReservationList creates a new (zeroed) IPReservationList struct with the TypeMetadata
+// initialized to the current version.
+func NewIPReservationList() *IPReservationList {
+	return &IPReservationList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindIPReservationList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
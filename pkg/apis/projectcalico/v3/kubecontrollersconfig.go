@@ -60,6 +60,23 @@ type KubeControllersConfigurationSpec struct {
 	// PrometheusMetricsPort is the TCP port that the Prometheus metrics server should bind to. Set to 0 to disable. [Default: 9094]
 	PrometheusMetricsPort *int `json:"prometheusMetricsPort,omitempty"`
 
+	// ReconcilerPeriod is the default period to perform reconciliation with the Calico datastore, used by controllers
+	// that do not set their own ReconcilerPeriod. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// WorkqueueMaxRetries is the number of times a controller retries reconciling a resource before dropping it
+	// from its workqueue. [Default: 5]
+	WorkqueueMaxRetries *int `json:"workqueueMaxRetries,omitempty" validate:"omitempty,gt=0"`
+
+	// WorkqueueBaseDelay is the delay before the first retry of a resource that failed to reconcile. The delay
+	// doubles with each further retry, up to WorkqueueMaxDelay. Must not be negative. [Default: 5ms]
+	WorkqueueBaseDelay *metav1.Duration `json:"workqueueBaseDelay,omitempty" validate:"omitempty"`
+
+	// WorkqueueMaxDelay is the maximum delay between retries of a resource that failed to reconcile. Must not be
+	// negative. [Default: 1000s]
+	WorkqueueMaxDelay *metav1.Duration `json:"workqueueMaxDelay,omitempty" validate:"omitempty"`
+
 	// Controllers enables and configures individual Kubernetes controllers
 	Controllers ControllersConfig `json:"controllers"`
 }
@@ -166,3 +183,14 @@ func NewKubeControllersConfiguration() *KubeControllersConfiguration {
 		},
 	}
 }
+
+// Validate returns an error if any of the spec's reconciliation or workqueue durations is negative.
+func (s *KubeControllersConfigurationSpec) Validate() error {
+	if err := validateNonNegativeDuration("reconcilerPeriod", s.ReconcilerPeriod); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("workqueueBaseDelay", s.WorkqueueBaseDelay); err != nil {
+		return err
+	}
+	return validateNonNegativeDuration("workqueueMaxDelay", s.WorkqueueMaxDelay)
+}
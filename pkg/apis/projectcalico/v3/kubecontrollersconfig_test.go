@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("KubeControllersConfigurationSpec.Validate",
+	func(spec KubeControllersConfigurationSpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("durations unset", KubeControllersConfigurationSpec{}, false),
+	Entry("zero durations", KubeControllersConfigurationSpec{
+		ReconcilerPeriod:   &metav1.Duration{},
+		WorkqueueBaseDelay: &metav1.Duration{},
+		WorkqueueMaxDelay:  &metav1.Duration{},
+	}, false),
+	Entry("positive durations", KubeControllersConfigurationSpec{
+		ReconcilerPeriod:   &metav1.Duration{Duration: 5 * time.Minute},
+		WorkqueueBaseDelay: &metav1.Duration{Duration: 5 * time.Millisecond},
+		WorkqueueMaxDelay:  &metav1.Duration{Duration: 1000 * time.Second},
+	}, false),
+	Entry("negative reconciler period", KubeControllersConfigurationSpec{
+		ReconcilerPeriod: &metav1.Duration{Duration: -time.Minute},
+	}, true),
+	Entry("negative workqueue base delay", KubeControllersConfigurationSpec{
+		WorkqueueBaseDelay: &metav1.Duration{Duration: -time.Millisecond},
+	}, true),
+	Entry("negative workqueue max delay", KubeControllersConfigurationSpec{
+		WorkqueueMaxDelay: &metav1.Duration{Duration: -time.Second},
+	}, true),
+)
@@ -33,6 +33,14 @@ const (
 	Enterprise     LicensePackageType = "Enterprise"
 )
 
+type LicenseState string
+
+const (
+	LicenseStateValid   LicenseState = "Valid"
+	LicenseStateExpired LicenseState = "Expired"
+	LicenseStateInvalid LicenseState = "Invalid"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +59,7 @@ type LicenseKey struct {
 // LicenseKeySpec contains the license key itself.
 type LicenseKeySpec struct {
 	// Token is the JWT containing the license claims
+	// +kubebuilder:validation:MinLength=1
 	Token string `json:"token" yaml:"token"`
 	// Certificate is used to validate the token.
 	Certificate string `json:"certificate,omitempty" yaml:"certificate" validate:"omitempty"`
@@ -67,6 +76,14 @@ type LicenseKeyStatus struct {
 	Package LicensePackageType `json:"package,omitempty" yaml:"package" validate:"omitempty"`
 	// List of features that are available via the applied license
 	Features []string `json:"features,omitempty" yaml:"features" validate:"omitempty"`
+	// ValidUntil is the end of the license's grace period, after which the licensed features are disabled.  It is
+	// not earlier than Expiry.
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty" yaml:"validUntil"`
+	// State summarises the validity of the license: "Valid", "Expired" or "Invalid".
+	// +kubebuilder:validation:Enum=Valid;Expired;Invalid
+	// +optional
+	State LicenseState `json:"state,omitempty" yaml:"state" validate:"omitempty,oneof=Valid Expired Invalid"`
 }
 
 // +genclient:nonNamespaced
@@ -3,6 +3,8 @@
 package v3
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,6 +48,37 @@ type ManagedClusterSpec struct {
 	// Field to store dynamically generated manifest for installing component into
 	// the actual application cluster corresponding to this Managed Cluster
 	InstallationManifest string `json:"installationManifest,omitempty"`
+
+	// ConnectionTimeout is how long the management cluster waits for a response from the managed
+	// cluster before treating the connection as lost.  Must be greater than zero.  [Default: 30s]
+	// +optional
+	ConnectionTimeout *metav1.Duration `json:"connectionTimeout,omitempty" validate:"omitempty"`
+
+	// RetryInterval is the time the management cluster waits between attempts to reconnect to the
+	// managed cluster.  Must not be negative.  [Default: 5s]
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty" validate:"omitempty"`
+
+	// MaxRetries is the number of reconnection attempts made before the managed cluster is
+	// reported as disconnected.  [Default: 3]
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty" validate:"omitempty,gte=0"`
+}
+
+// Validate returns an error if ConnectionTimeout is not positive, or if RetryInterval or MaxRetries is
+// negative.
+func (s *ManagedClusterSpec) Validate() error {
+	if s.ConnectionTimeout != nil && s.ConnectionTimeout.Duration <= 0 {
+		return fmt.Errorf("connectionTimeout must be greater than zero, got %v", s.ConnectionTimeout.Duration)
+	}
+	if err := validateNonNegativeDuration("retryInterval", s.RetryInterval); err != nil {
+		return err
+	}
+	if s.MaxRetries != nil && *s.MaxRetries < 0 {
+		return fmt.Errorf("maxRetries must not be negative, got %d", *s.MaxRetries)
+	}
+	return nil
 }
 
 type ManagedClusterStatus struct {
@@ -0,0 +1,45 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("ManagedClusterSpec.Validate",
+	func(spec ManagedClusterSpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("connection settings unset", ManagedClusterSpec{}, false),
+	Entry("valid connection settings", ManagedClusterSpec{
+		ConnectionTimeout: &metav1.Duration{Duration: 30 * time.Second},
+		RetryInterval:     &metav1.Duration{Duration: 5 * time.Second},
+		MaxRetries:        intPtr(3),
+	}, false),
+	Entry("zero retry interval and max retries", ManagedClusterSpec{
+		RetryInterval: &metav1.Duration{},
+		MaxRetries:    intPtr(0),
+	}, false),
+	Entry("zero connection timeout", ManagedClusterSpec{ConnectionTimeout: &metav1.Duration{}}, true),
+	Entry("negative connection timeout", ManagedClusterSpec{ConnectionTimeout: &metav1.Duration{Duration: -time.Second}}, true),
+	Entry("negative retry interval", ManagedClusterSpec{RetryInterval: &metav1.Duration{Duration: -time.Second}}, true),
+	Entry("negative max retries", ManagedClusterSpec{MaxRetries: intPtr(-1)}, true),
+)
@@ -106,6 +106,23 @@ type NetworkPolicySpec struct {
 
 	// ServiceAccountSelector is an optional field for an expression used to select a pod based on service accounts.
 	ServiceAccountSelector string `json:"serviceAccountSelector,omitempty" validate:"selector"`
+
+	// EndpointType limits the policy to the given kind of endpoint.  Only "WorkloadEndpoint" is allowed
+	// because host endpoints are not namespaced and can only be selected by global policies.  If omitted,
+	// the policy applies to all endpoint types that its selector matches.
+	// +optional
+	EndpointType PolicyEndpointType `json:"endpointType,omitempty" validate:"omitempty,oneof=WorkloadEndpoint"`
+
+	// StagedMode indicates that the policy is evaluated and its decisions are logged, but not
+	// enforced.  This allows the effect of a policy change to be observed before it takes effect.
+	// [Default: false]
+	// +optional
+	StagedMode *bool `json:"stagedMode,omitempty"`
+}
+
+// Validate returns an error if EndpointType is set to anything other than WorkloadEndpoint.
+func (s *NetworkPolicySpec) Validate() error {
+	return validateNamespacedEndpointType(s.EndpointType)
 }
 
 // NewNetworkPolicy creates a new (zeroed) NetworkPolicy struct with the TypeMetadata initialised to the current
@@ -18,6 +18,7 @@ import (
 	"reflect"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -26,11 +27,11 @@ import (
 var (
 	// gnpExtraFields is the set of fields that should be in GlobalNetworkPolicy but not
 	// NetworkPolicy.
-	gnpExtraFields = From("DoNotTrack", "PreDNAT", "ApplyOnForward", "NamespaceSelector")
+	gnpExtraFields = From("DoNotTrack", "PreDNAT", "ApplyOnForward", "NamespaceSelector", "EgressGateway", "EndpointTypes")
 
 	// npExtraFields is the set of fields that should be in NetworkPolicy but not
 	// GlobalNetworkPolicy.
-	npExtraFields = From()
+	npExtraFields = From("EndpointType")
 )
 
 // These tests verify that the NetworkPolicySpec struct and the GlobalNetworkPolicySpec struct
@@ -105,3 +106,17 @@ func (s set) Contains(item string) bool {
 	_, present := s[item]
 	return present
 }
+
+var _ = DescribeTable("NetworkPolicySpec.Validate",
+	func(endpointType PolicyEndpointType, expectErr bool) {
+		spec := NetworkPolicySpec{EndpointType: endpointType}
+		expectValidationResult(spec.Validate(), expectErr)
+
+		staged := StagedNetworkPolicySpec{EndpointType: endpointType}
+		expectValidationResult(staged.Validate(), expectErr)
+	},
+	Entry("endpoint type unset", PolicyEndpointType(""), false),
+	Entry("workload endpoints", PolicyEndpointTypeWorkload, false),
+	Entry("host endpoints", PolicyEndpointTypeHost, true),
+	Entry("unknown endpoint type", PolicyEndpointType("NetworkSet"), true),
+)
@@ -3,6 +3,8 @@
 package v3
 
 import (
+	"errors"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/tigera/api/pkg/lib/numorstring"
@@ -29,6 +31,8 @@ type PacketCapture struct {
 }
 
 // PacketCaptureSpec contains the values of the packet capture.
+// +kubebuilder:validation:XValidation:rule="!(has(self.startTime) && has(self.cronSchedule))",message="startTime and cronSchedule cannot both be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.startTime) || !has(self.endTime) || self.endTime > self.startTime",message="endTime must be after startTime"
 type PacketCaptureSpec struct {
 	// The selector is an expression used to pick out the endpoints that the policy should
 	// be applied to.  The selector will only match endpoints in the same namespace as the
@@ -61,6 +65,38 @@ type PacketCaptureSpec struct {
 	// The ordered set of filters applied to traffic captured from an interface.  Each rule contains a set of
 	// packet match criteria.
 	Filters []PacketCaptureRule `json:"filters,omitempty" validate:"omitempty,dive"`
+
+	// Defines the time from which this PacketCapture will start capturing traffic.  If omitted,
+	// the capture starts as soon as the resource is created.  Cannot be combined with CronSchedule.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Defines the time at which this PacketCapture will stop capturing traffic.  If omitted, the
+	// capture runs until the resource is deleted.  Must be after StartTime when both are set.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// A standard five-field cron expression, such as "0 2 * * 0", that defines when the capture
+	// is started.  Cannot be combined with StartTime.
+	// +optional
+	CronSchedule string `json:"cronSchedule,omitempty" validate:"omitempty,cronSchedule"`
+}
+
+// Validate returns an error if CronSchedule is not a valid cron expression, if both StartTime and
+// CronSchedule are set, or if EndTime is not after StartTime.
+func (s *PacketCaptureSpec) Validate() error {
+	if s.CronSchedule != "" {
+		if err := ValidateCronSchedule(s.CronSchedule); err != nil {
+			return err
+		}
+	}
+	if s.StartTime != nil && s.CronSchedule != "" {
+		return errors.New("startTime and cronSchedule cannot both be set")
+	}
+	if s.StartTime != nil && s.EndTime != nil && !s.EndTime.After(s.StartTime.Time) {
+		return errors.New("endTime must be after startTime")
+	}
+	return nil
 }
 
 // A PacketCaptureRule encapsulates a set of match criteria for traffic captured from an interface.
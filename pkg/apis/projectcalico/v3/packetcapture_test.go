@@ -0,0 +1,46 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var (
+	captureStart = metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	captureEnd   = metav1.NewTime(captureStart.Add(time.Hour))
+)
+
+var _ = DescribeTable("PacketCaptureSpec.Validate",
+	func(spec PacketCaptureSpec, expectErr bool) {
+		expectValidationResult(spec.Validate(), expectErr)
+	},
+	Entry("no schedule", PacketCaptureSpec{}, false),
+	Entry("invalid cron schedule", PacketCaptureSpec{CronSchedule: "0 2 * *"}, true),
+	Entry("start time only", PacketCaptureSpec{StartTime: &captureStart}, false),
+	Entry("end time only", PacketCaptureSpec{EndTime: &captureEnd}, false),
+	Entry("cron schedule only", PacketCaptureSpec{CronSchedule: "0 2 * * 0"}, false),
+	Entry("cron schedule with end time", PacketCaptureSpec{CronSchedule: "0 2 * * 0", EndTime: &captureEnd}, false),
+	Entry("end time after start time", PacketCaptureSpec{StartTime: &captureStart, EndTime: &captureEnd}, false),
+	Entry("start time with cron schedule", PacketCaptureSpec{StartTime: &captureStart, CronSchedule: "0 2 * * 0"}, true),
+	Entry("end time equal to start time", PacketCaptureSpec{StartTime: &captureStart, EndTime: &captureStart}, true),
+	Entry("end time before start time", PacketCaptureSpec{StartTime: &captureEnd, EndTime: &captureStart}, true),
+)
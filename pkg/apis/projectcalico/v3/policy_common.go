@@ -15,6 +15,8 @@
 package v3
 
 import (
+	"fmt"
+
 	"github.com/tigera/api/pkg/lib/numorstring"
 )
 
@@ -26,6 +28,24 @@ const (
 	PolicyTypeEgress  PolicyType = "Egress"
 )
 
+// PolicyEndpointType enumerates the kinds of endpoint that a policy can be scoped to.
+// +kubebuilder:validation:Enum=WorkloadEndpoint;HostEndpoint
+type PolicyEndpointType string
+
+const (
+	PolicyEndpointTypeWorkload PolicyEndpointType = "WorkloadEndpoint"
+	PolicyEndpointTypeHost     PolicyEndpointType = "HostEndpoint"
+)
+
+// validateNamespacedEndpointType returns an error if t is set to anything other than WorkloadEndpoint, since a
+// namespaced policy cannot select host endpoints.
+func validateNamespacedEndpointType(t PolicyEndpointType) error {
+	if t != "" && t != PolicyEndpointTypeWorkload {
+		return fmt.Errorf("endpointType must be %s for a namespaced policy, got %q", PolicyEndpointTypeWorkload, t)
+	}
+	return nil
+}
+
 // A Rule encapsulates a set of match criteria and an action.  Both selector-based security Policy
 // and security Profiles reference rules - separated out as a list of rules for both
 // ingress and egress packet matching.
@@ -58,6 +78,18 @@ type Rule struct {
 	// Destination contains the match criteria that apply to destination entity.
 	Destination EntityRule `json:"destination,omitempty" validate:"omitempty"`
 
+	// NamespaceSelector is an optional field that contains a selector expression.  When set, the
+	// rule only matches traffic whose peer endpoint - the source for ingress rules and the
+	// destination for egress rules - is in a namespace whose labels match the selector.  This
+	// allows a namespaced NetworkPolicy to match endpoints in other namespaces without resorting
+	// to a GlobalNetworkPolicy.
+	//
+	// Neither selector takes precedence over the other: if the peer EntityRule also sets
+	// NamespaceSelector, the endpoint's namespace must match both selectors (see
+	// PeerNamespaceSelector), and the EntityRule's Selector is evaluated within the namespaces
+	// selected here rather than the policy's own namespace.  The other EntityRule is unaffected.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"omitempty,selector"`
+
 	// HTTP contains match criteria that apply to HTTP requests.
 	HTTP *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
 
@@ -73,13 +105,19 @@ type HTTPPath struct {
 	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
 }
 
+// HTTPHeaderMatch matches an HTTP request header by name and exact value.
+type HTTPHeaderMatch struct {
+	Name  string `json:"name" validate:"required,httpHeaderName"`
+	Value string `json:"value,omitempty"`
+}
+
 // HTTPMatch is an optional field that apply only to HTTP requests
-// The Methods and Path fields are joined with AND
+// The Methods, Path and Headers fields are joined with AND
 type HTTPMatch struct {
 	// Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed
 	// HTTP Methods (e.g. GET, PUT, etc.)
-	// Multiple methods are OR'd together.
-	Methods []string `json:"methods,omitempty" validate:"omitempty"`
+	// Multiple methods are OR'd together.  Each method must be one of the methods defined by RFC 7231 (or PATCH).
+	Methods []string `json:"methods,omitempty" validate:"omitempty,dive,httpMethod"`
 	// Paths is an optional field that restricts the rule to apply to HTTP requests that use one of the listed
 	// HTTP Paths.
 	// Multiple paths are OR'd together.
@@ -88,6 +126,9 @@ type HTTPMatch struct {
 	// - prefix: /bar
 	// NOTE: Each entry may ONLY specify either a `exact` or a `prefix` match. The validator will check for it.
 	Paths []HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	// Headers is an optional field that restricts the rule to apply to HTTP requests that carry all of the listed
+	// headers with the given values.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty,dive"`
 }
 
 // ICMPFields defines structure for ICMP and NotICMP sub-struct for ICMP code and type
@@ -148,6 +189,13 @@ type EntityRule struct {
 	// source (destination) port that matches one of these ranges/values. This value is a
 	// list of integers or strings that represent ranges of ports.
 	//
+	// An entry may also be the name of a port, such as "http", rather than a number.  Named
+	// ports are resolved when the policy is enforced, using the container ports declared by
+	// each matching pod (or the named ports of each matching host endpoint), so a policy does
+	// not need to know the numeric port at authoring time.  The name must be a valid port
+	// name (an IANA_SVC_NAME) and the protocol of the named port must match the rule's
+	// Protocol.
+	//
 	// Since only some protocols have ports, if any ports are specified it requires the
 	// Protocol match in the Rule to be set to "TCP" or "UDP".
 	Ports []numorstring.Port `json:"ports,omitempty" validate:"omitempty,dive"`
@@ -157,6 +205,22 @@ type EntityRule struct {
 	// Action must be Allow, and Nets and Selector must both be left empty.
 	Domains []string `json:"domains,omitempty" validate:"omitempty,dive,wildname"`
 
+	// DNSNames is an optional field that restricts the rule to only apply to traffic that
+	// originates from (or terminates at) IP addresses that Felix's DNS cache has recorded as
+	// resolutions of one of the given names.  Each name is a fully qualified domain name or a
+	// wildcard such as "*.example.com".  Unlike Domains, DNSNames may be used with any action
+	// and in both source and destination entity rules.  It relies on Felix learning DNS
+	// resolutions, so DNSTrustedServers must include the cluster's DNS servers.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty" validate:"omitempty,dive,dnsName"`
+
+	// DNSLabelSelector is an optional field that contains a selector expression matched
+	// against the labels of the DNS names tracked by Felix's DNS cache.  Only traffic that
+	// originates from (or terminates at) IP addresses resolved from a matching DNS name will
+	// be matched.  Like DNSNames, it relies on DNSTrustedServers being configured.
+	// +optional
+	DNSLabelSelector string `json:"dnsLabelSelector,omitempty" validate:"omitempty,selector"`
+
 	// NotNets is the negated version of the Nets field.
 	NotNets []string `json:"notNets,omitempty" validate:"omitempty,dive,net"`
 
@@ -201,7 +265,33 @@ const (
 	StagedActionDelete StagedAction = "Delete"
 )
 
+// StagedPolicyStatus reports the progress of a staged policy towards being promoted to an enforced policy.
+type StagedPolicyStatus struct {
+	// ActivePolicyRef is the name of the enforced policy that this staged policy was promoted to, or that
+	// it would replace if promoted.
+	// +optional
+	ActivePolicyRef string `json:"activePolicyRef,omitempty"`
+	// StagingStatus is a short, human readable description of the state of the staged policy, as reported
+	// by the controller that manages promotion.
+	// +optional
+	StagingStatus string `json:"stagingStatus,omitempty"`
+}
+
 type RuleMetadata struct {
 	// Annotations is a set of key value pairs that give extra information about the rule
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
+
+// PeerNamespaceSelector returns the namespace selector that applies to the given peer EntityRule
+// of the rule, which is the source for ingress rules and the destination for egress rules.  When
+// both the rule and the peer set a NamespaceSelector, the result requires both to match.
+func (r Rule) PeerNamespaceSelector(peer EntityRule) string {
+	switch {
+	case r.NamespaceSelector == "":
+		return peer.NamespaceSelector
+	case peer.NamespaceSelector == "":
+		return r.NamespaceSelector
+	default:
+		return fmt.Sprintf("(%s) && (%s)", r.NamespaceSelector, peer.NamespaceSelector)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("Rule.PeerNamespaceSelector",
+	func(ruleSelector, peerSelector, expected string) {
+		r := Rule{NamespaceSelector: ruleSelector, Source: EntityRule{NamespaceSelector: peerSelector}}
+		Expect(r.PeerNamespaceSelector(r.Source)).To(Equal(expected))
+	},
+	Entry("neither set", "", "", ""),
+	Entry("only the rule selector set", "team == 'a'", "", "team == 'a'"),
+	Entry("only the peer selector set", "", "env == 'prod'", "env == 'prod'"),
+	Entry("both set", "team == 'a'", "env == 'prod'", "(team == 'a') && (env == 'prod')"),
+)
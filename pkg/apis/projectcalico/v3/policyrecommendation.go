@@ -0,0 +1,95 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindPolicyRecommendation     = "PolicyRecommendation"
+	KindPolicyRecommendationList = "PolicyRecommendationList"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+
+// PolicyRecommendation configures the generation of recommended network policies for a set of endpoints, based on
+// the traffic observed to and from them.  The recommendations are reported in its status.
+type PolicyRecommendation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the PolicyRecommendation.
+	Spec PolicyRecommendationSpec `json:"spec,omitempty"`
+	// Status of the PolicyRecommendation.
+	Status PolicyRecommendationStatus `json:"status,omitempty"`
+}
+
+// PolicyRecommendationSpec contains the specification for a PolicyRecommendation resource.
+type PolicyRecommendationSpec struct {
+	// Selector is an expression used to pick out the endpoints that policies are recommended for.
+	Selector string `json:"selector,omitempty" validate:"omitempty,selector"`
+
+	// NamespaceSelector is an expression used to pick out the namespaces of the endpoints that policies are
+	// recommended for.  If omitted, endpoints in all namespaces are considered.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"omitempty,selector"`
+
+	// StabilizationPeriod is how long the observed traffic patterns of an endpoint must remain unchanged before a
+	// recommendation is generated for it.
+	// +optional
+	StabilizationPeriod *metav1.Duration `json:"stabilizationPeriod,omitempty" validate:"omitempty"`
+}
+
+// PolicyRecommendationStatus contains the policies recommended by a PolicyRecommendation resource.
+type PolicyRecommendationStatus struct {
+	// Recommendations is the set of policies that are currently recommended.
+	// +optional
+	Recommendations []RecommendedPolicy `json:"recommendations,omitempty"`
+}
+
+// RecommendedPolicy is a single recommended network policy.
+type RecommendedPolicy struct {
+	// Name is the name that the recommended policy would be created with.
+	Name string `json:"name"`
+	// Namespace is the namespace that the recommended policy would be created in.
+	Namespace string `json:"namespace"`
+	// Spec is the specification of the recommended policy.
+	Spec NetworkPolicySpec `json:"spec"`
+	// Confidence is the confidence in the recommendation, from 0 (none) to 1 (certain).
+	Confidence float64 `json:"confidence"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PolicyRecommendationList contains a list of PolicyRecommendation resources.
+type PolicyRecommendationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []PolicyRecommendation `json:"items"`
+}
+
+// NewPolicyRecommendation creates a new (zeroed) PolicyRecommendation struct with the TypeMetadata
+// initialized to the current version.
+func NewPolicyRecommendation() *PolicyRecommendation {
+	return &PolicyRecommendation{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindPolicyRecommendation,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// NewPolicyRecommendationList creates a new (zeroed) PolicyRecommendationList struct with the TypeMetadata
+// initialized to the current version.
+func NewPolicyRecommendationList() *PolicyRecommendationList {
+	return &PolicyRecommendationList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindPolicyRecommendationList,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
@@ -40,10 +40,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&HostEndpointList{},
 		&IPPool{},
 		&IPPoolList{},
+		&IPReservation{},
+		&IPReservationList{},
 		&BGPConfiguration{},
 		&BGPConfigurationList{},
 		&BGPPeer{},
 		&BGPPeerList{},
+		&BGPFilter{},
+		&BGPFilterList{},
 		&Profile{},
 		&ProfileList{},
 		&FelixConfiguration{},
@@ -54,6 +58,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterInformationList{},
 		&NetworkSet{},
 		&NetworkSetList{},
+		&EgressGateway{},
+		&EgressGatewayList{},
+		&AlertException{},
+		&AlertExceptionList{},
 		&GlobalAlert{},
 		&GlobalAlertList{},
 		&GlobalAlertTemplate{},
@@ -62,6 +70,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GlobalReportList{},
 		&GlobalReportType{},
 		&GlobalReportTypeList{},
+		&GeneratedReport{},
+		&GeneratedReportList{},
+		&PolicyRecommendation{},
+		&PolicyRecommendationList{},
 		&GlobalThreatFeed{},
 		&GlobalThreatFeedList{},
 		&LicenseKey{},
@@ -0,0 +1,64 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("RouteTableRange.Validate",
+	func(r RouteTableRange, expectErr bool) {
+		if expectErr {
+			Expect(r.Validate()).To(HaveOccurred())
+		} else {
+			Expect(r.Validate()).NotTo(HaveOccurred())
+		}
+	},
+	Entry("typical range", RouteTableRange{Min: 1, Max: 250}, false),
+	Entry("min equal to max", RouteTableRange{Min: 100, Max: 100}, false),
+	Entry("max at the kernel limit", RouteTableRange{Min: 65536, Max: math.MaxInt32}, false),
+	Entry("min greater than max", RouteTableRange{Min: 200, Max: 100}, true),
+	Entry("zero min", RouteTableRange{Min: 0, Max: 250}, true),
+	Entry("negative min", RouteTableRange{Min: -1, Max: 250}, true),
+	Entry("max beyond the kernel limit", RouteTableRange{Min: 1, Max: math.MaxInt32 + 1}, true),
+)
+
+var _ = DescribeTable("ValidateRouteTableRanges",
+	func(ranges []RouteTableRange, expectErr bool) {
+		if expectErr {
+			Expect(ValidateRouteTableRanges(ranges)).To(HaveOccurred())
+		} else {
+			Expect(ValidateRouteTableRanges(ranges)).NotTo(HaveOccurred())
+		}
+	},
+	Entry("no ranges", nil, false),
+	Entry("single range below the reserved tables", []RouteTableRange{{Min: 1, Max: 250}}, false),
+	Entry("ranges either side of the reserved tables",
+		[]RouteTableRange{{Min: 1, Max: 252}, {Min: 256, Max: 1000}}, false),
+	Entry("adjacent ranges", []RouteTableRange{{Min: 1, Max: 100}, {Min: 101, Max: 200}}, false),
+	Entry("unsorted disjoint ranges", []RouteTableRange{{Min: 300, Max: 400}, {Min: 1, Max: 100}}, false),
+	Entry("invalid range", []RouteTableRange{{Min: 200, Max: 100}}, true),
+	Entry("range covering the reserved tables", []RouteTableRange{{Min: 1, Max: 300}}, true),
+	Entry("range ending on a reserved table", []RouteTableRange{{Min: 200, Max: 253}}, true),
+	Entry("range starting on a reserved table", []RouteTableRange{{Min: 255, Max: 300}}, true),
+	Entry("overlapping ranges", []RouteTableRange{{Min: 1, Max: 100}, {Min: 100, Max: 200}}, true),
+	Entry("unsorted overlapping ranges", []RouteTableRange{{Min: 150, Max: 200}, {Min: 1, Max: 160}}, true),
+	Entry("nested ranges", []RouteTableRange{{Min: 1, Max: 200}, {Min: 50, Max: 60}}, true),
+)
@@ -27,6 +27,7 @@ const (
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
 
 // StagedGlobalNetworkPolicy is a staged GlobalNetworkPolicy.
 type StagedGlobalNetworkPolicy struct {
@@ -35,6 +36,8 @@ type StagedGlobalNetworkPolicy struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of the Policy.
 	Spec StagedGlobalNetworkPolicySpec `json:"spec,omitempty"`
+	// Status of the staged policy.
+	Status StagedPolicyStatus `json:"status,omitempty"`
 }
 
 type StagedGlobalNetworkPolicySpec struct {
@@ -116,6 +119,17 @@ type StagedGlobalNetworkPolicySpec struct {
 
 	// NamespaceSelector is an optional field for an expression used to select a pod based on namespaces.
 	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"selector"`
+
+	// EgressGateway is an optional field that directs traffic matched by this policy through the
+	// egress gateways it selects.  It is only honoured when Felix's EgressIPSupport is enabled;
+	// this is not enforced at admission time because the Felix configuration is a separate resource.
+	// +optional
+	EgressGateway *EgressGatewayRef `json:"egressGateway,omitempty" validate:"omitempty"`
+
+	// EndpointTypes limits the policy to the given kinds of endpoint.  If omitted, the policy applies
+	// to all endpoint types that its selector matches.
+	// +optional
+	EndpointTypes []PolicyEndpointType `json:"endpointTypes,omitempty" validate:"omitempty,dive,oneof=WorkloadEndpoint HostEndpoint"`
 }
 
 // +genclient:nonNamespaced
@@ -32,7 +32,7 @@ var (
 
 	// globalnpExtraFields is the set of fields that should be in GlobalNetworkPolicy but not
 	// StagedGlobalNetworkPolicy.
-	globalnpExtraFields = From()
+	globalnpExtraFields = From("StagedMode")
 )
 
 // These tests verify that the StagedGlobalNetworkPolicySpec struct and the GlobalNetworkPolicySpec struct
@@ -26,6 +26,7 @@ const (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
 
 // StagedNetworkPolicy is a staged NetworkPolicy.
 // StagedNetworkPolicy is the Namespaced-equivalent of the StagedGlobalNetworkPolicy.
@@ -35,6 +36,8 @@ type StagedNetworkPolicy struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of the Policy.
 	Spec StagedNetworkPolicySpec `json:"spec,omitempty"`
+	// Status of the staged policy.
+	Status StagedPolicyStatus `json:"status,omitempty"`
 }
 
 type StagedNetworkPolicySpec struct {
@@ -103,6 +106,17 @@ type StagedNetworkPolicySpec struct {
 
 	// ServiceAccountSelector is an optional field for an expression used to select a pod based on service accounts.
 	ServiceAccountSelector string `json:"serviceAccountSelector,omitempty" validate:"selector"`
+
+	// EndpointType limits the policy to the given kind of endpoint.  Only "WorkloadEndpoint" is allowed
+	// because host endpoints are not namespaced and can only be selected by global policies.  If omitted,
+	// the policy applies to all endpoint types that its selector matches.
+	// +optional
+	EndpointType PolicyEndpointType `json:"endpointType,omitempty" validate:"omitempty,oneof=WorkloadEndpoint"`
+}
+
+// Validate returns an error if EndpointType is set to anything other than WorkloadEndpoint.
+func (s *StagedNetworkPolicySpec) Validate() error {
+	return validateNamespacedEndpointType(s.EndpointType)
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -32,7 +32,7 @@ var (
 
 	// networkPolicyExtraFields is the set of fields that should be in NetworkPolicy but not
 	// StagedNetworkPolicy.
-	networkPolicyExtraFields = From()
+	networkPolicyExtraFields = From("StagedMode")
 )
 
 // These tests verify that the StagedNetworkPolicySpec struct and the NetworkPolicySpec struct
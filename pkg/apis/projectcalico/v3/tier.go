@@ -14,7 +14,12 @@
 
 package v3
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"fmt"
+	"math"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 const (
 	KindTier     = "Tier"
@@ -42,8 +47,16 @@ type TierSpec struct {
 	// Tiers with higher "order" are applied after those with lower order.  If the order
 	// is omitted, it may be considered to be "infinite" - i.e. the tier will be applied
 	// last.  Tiers with identical order will be applied in alphanumerical order based
-	// on the Tier "Name".
+	// on the Tier "Name".  If set, the order must be a positive number.
 	Order *float64 `json:"order,omitempty"`
+	// +kubebuilder:validation:Enum=Pass;Allow;Deny;Log
+	// DefaultAction specifies the action applied to traffic that reaches the end of the tier
+	// without being matched by any of its policies.  If omitted, the traffic is passed to the
+	// next tier.
+	DefaultAction Action `json:"defaultAction,omitempty" validate:"omitempty,oneof=Pass Allow Deny Log"`
+	// PassMatchedFlowsToNextTier specifies whether flows that are explicitly matched by a Pass
+	// rule in this tier continue to be evaluated by the subsequent tiers.  [Default: true]
+	PassMatchedFlowsToNextTier *bool `json:"passMatchedFlowsToNextTier,omitempty"`
 }
 
 // +genclient:nonNamespaced
@@ -77,3 +90,33 @@ func NewTierList() *TierList {
 		},
 	}
 }
+
+// Validate returns an error if the Tier's order is not a positive, finite number.  An unset order is valid and
+// places the tier last.
+func (t *Tier) Validate() error {
+	if o := t.Spec.Order; o != nil {
+		if math.IsNaN(*o) || math.IsInf(*o, 0) {
+			return fmt.Errorf("tier %s order must be a finite number", t.Name)
+		}
+		if *o <= 0 {
+			return fmt.Errorf("tier %s order %v must be positive", t.Name, *o)
+		}
+	}
+	return nil
+}
+
+// ValidateTierOrderUniqueness returns an error if two of the given tiers have the same order, which would leave
+// their relative evaluation order to depend on their names.  Tiers without an order are not compared.
+func ValidateTierOrderUniqueness(tiers []Tier) error {
+	names := map[float64]string{}
+	for _, t := range tiers {
+		if t.Spec.Order == nil {
+			continue
+		}
+		if other, ok := names[*t.Spec.Order]; ok {
+			return fmt.Errorf("tiers %s and %s have the same order %v", other, t.Name, *t.Spec.Order)
+		}
+		names[*t.Spec.Order] = t.Name
+	}
+	return nil
+}
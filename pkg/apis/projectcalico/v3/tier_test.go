@@ -0,0 +1,70 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+func tier(name string, order *float64) Tier {
+	return Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       TierSpec{Order: order},
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+var _ = DescribeTable("Tier.Validate",
+	func(order *float64, expectErr bool) {
+		t := tier("t", order)
+		if expectErr {
+			Expect(t.Validate()).To(HaveOccurred())
+		} else {
+			Expect(t.Validate()).NotTo(HaveOccurred())
+		}
+	},
+	Entry("unset order", nil, false),
+	Entry("positive order", floatPtr(100), false),
+	Entry("fractional order", floatPtr(0.5), false),
+	Entry("zero order", floatPtr(0), true),
+	Entry("negative order", floatPtr(-1), true),
+	Entry("NaN order", floatPtr(math.NaN()), true),
+	Entry("infinite order", floatPtr(math.Inf(1)), true),
+)
+
+var _ = DescribeTable("ValidateTierOrderUniqueness",
+	func(tiers []Tier, expectErr bool) {
+		if expectErr {
+			Expect(ValidateTierOrderUniqueness(tiers)).To(HaveOccurred())
+		} else {
+			Expect(ValidateTierOrderUniqueness(tiers)).NotTo(HaveOccurred())
+		}
+	},
+	Entry("no tiers", nil, false),
+	Entry("single tier", []Tier{tier("a", floatPtr(100))}, false),
+	Entry("distinct orders", []Tier{tier("a", floatPtr(100)), tier("b", floatPtr(200))}, false),
+	Entry("duplicate orders", []Tier{tier("a", floatPtr(100)), tier("b", floatPtr(200)), tier("c", floatPtr(100))}, true),
+	Entry("several tiers without an order", []Tier{tier("a", nil), tier("b", nil)}, false),
+)
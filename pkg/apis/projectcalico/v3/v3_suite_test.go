@@ -28,3 +28,12 @@ func TestV2(t *testing.T) {
 	junitReporter := reporters.NewJUnitReporter("../../../report/v3_api_suite.xml")
 	RunSpecsWithDefaultAndCustomReporters(t, "v3 API Suite", []Reporter{junitReporter})
 }
+
+// expectValidationResult asserts that err is set exactly when a validation error is expected.
+func expectValidationResult(err error, expectErr bool) {
+	if expectErr {
+		ExpectWithOffset(1, err).To(HaveOccurred())
+	} else {
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tigera/api/pkg/lib/numorstring"
+)
+
+// The functions in this file implement the custom validators that are named in the validate tags of this package and
+// that are specific to its types.  Consumers of the API register each of them with their validator under the tag
+// name given in its comment, so that the tag and the function agree on what is accepted.
+
+// httpHeaderNameRegex matches an RFC 7230 token, which is the syntax of an HTTP header field name.
+var httpHeaderNameRegex = regexp.MustCompile("^[-!#$%&'*+.^_`|~0-9A-Za-z]+$")
+
+// ValidateHTTPHeaderName implements the httpHeaderName validator.  It returns an error if name is not a valid HTTP
+// header field name.
+func ValidateHTTPHeaderName(name string) error {
+	if !httpHeaderNameRegex.MatchString(name) {
+		return fmt.Errorf("%q is not a valid HTTP header name", name)
+	}
+	return nil
+}
+
+// bpfProgramNameRegex matches the names that the kernel accepts for BPF objects: at most 15 alphanumerics,
+// underscores and dots.
+var bpfProgramNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.]{1,15}$`)
+
+// ValidateBPFProgramName implements the bpfProgramName validator.  It returns an error if name is not a valid BPF
+// program name.
+func ValidateBPFProgramName(name string) error {
+	if !bpfProgramNameRegex.MatchString(name) {
+		return fmt.Errorf("%q is not a valid BPF program name", name)
+	}
+	return nil
+}
+
+// bpfFilterTokenRegex splits a BPF filter expression into parentheses, logical operators and the words of its
+// primitives.
+var bpfFilterTokenRegex = regexp.MustCompile(`\(|\)|&&|\|\||!=|!|[^\s()!&|]+|[&|]`)
+
+// ValidateBPFFilter implements the bpfFilter validator.  It returns an error if filter is not a plausible
+// libpcap-style filter expression: it must be printable ASCII with balanced parentheses, and every "and", "or" and
+// closing parenthesis must follow a complete primitive.  The primitives themselves are checked by Felix when it
+// compiles the filter.
+func ValidateBPFFilter(filter string) error {
+	if strings.TrimSpace(filter) == "" {
+		return errors.New("BPF filter must not be empty")
+	}
+	for _, c := range filter {
+		if c < ' ' || c > '~' {
+			return fmt.Errorf("BPF filter %q contains a character that is not printable ASCII", filter)
+		}
+	}
+
+	depth := 0
+	expectOperand := true
+	for _, token := range bpfFilterTokenRegex.FindAllString(filter, -1) {
+		switch token {
+		case "(":
+			depth++
+			expectOperand = true
+		case ")", "and", "or", "&&", "||":
+			if expectOperand {
+				return fmt.Errorf("BPF filter %q has %q without a preceding primitive", filter, token)
+			}
+			if token == ")" {
+				depth--
+				if depth < 0 {
+					return fmt.Errorf("BPF filter %q has unbalanced parentheses", filter)
+				}
+			} else {
+				expectOperand = true
+			}
+		case "not", "!":
+			expectOperand = true
+		default:
+			expectOperand = false
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("BPF filter %q has unbalanced parentheses", filter)
+	}
+	if expectOperand {
+		return fmt.Errorf("BPF filter %q is incomplete", filter)
+	}
+	return nil
+}
+
+// ValidateTLSCipherSuite implements the tlsCipherSuite validator.  It returns an error if name is not the name of a
+// TLS cipher suite implemented by Go's crypto/tls package, such as "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256".
+func ValidateTLSCipherSuite(name string) error {
+	for _, suites := range [][]*tls.CipherSuite{tls.CipherSuites(), tls.InsecureCipherSuites()} {
+		for _, suite := range suites {
+			if suite.Name == name {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%q is not a known TLS cipher suite", name)
+}
+
+// cronField describes the values allowed in one field of a cron schedule.  Names, if set, are the three letter
+// names that may be used in place of the values starting at min.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronDescriptors are the shorthands that may be used in place of the five fields of a cron schedule.
+var cronDescriptors = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// ValidateCronSchedule implements the cronSchedule validator.  It returns an error if schedule is not a standard
+// five-field cron expression, such as "0 2 * * 0", or one of the descriptors such as "@daily".  Each field is a
+// comma-separated list of "*", values or ranges, each optionally followed by a "/step", and the month and day of
+// week fields also accept three letter names.
+func ValidateCronSchedule(schedule string) error {
+	if cronDescriptors[schedule] {
+		return nil
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("cron schedule %q must have %d fields, got %d", schedule, len(cronFields), len(fields))
+	}
+	for i, f := range fields {
+		for _, item := range strings.Split(f, ",") {
+			if err := cronFields[i].validateItem(item); err != nil {
+				return fmt.Errorf("cron schedule %q has an invalid %s field: %v", schedule, cronFields[i].name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateItem returns an error if item is not a valid entry in a list for the field.
+func (c cronField) validateItem(item string) error {
+	parts := strings.SplitN(item, "/", 2)
+	if len(parts) == 2 {
+		if n, err := strconv.Atoi(parts[1]); err != nil || n < 1 {
+			return fmt.Errorf("invalid step %q", parts[1])
+		}
+	}
+	rng := parts[0]
+	if rng == "*" {
+		return nil
+	}
+	bounds := strings.SplitN(rng, "-", 2)
+	lo, err := c.value(bounds[0])
+	if err != nil {
+		return err
+	}
+	if len(bounds) == 1 {
+		return nil
+	}
+	hi, err := c.value(bounds[1])
+	if err != nil {
+		return err
+	}
+	if lo > hi {
+		return fmt.Errorf("range %q is reversed", rng)
+	}
+	return nil
+}
+
+// value parses a single value or name for the field.
+func (c cronField) value(s string) (int, error) {
+	for i, name := range c.names {
+		if strings.EqualFold(s, name) {
+			return c.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < c.min || n > c.max {
+		return 0, fmt.Errorf("%q is not a value between %d and %d", s, c.min, c.max)
+	}
+	return n, nil
+}
+
+// bgpWellKnownCommunities are the names of the RFC 1997 and RFC 3765 well-known BGP communities.
+var bgpWellKnownCommunities = map[string]bool{
+	"no-export": true, "no-advertise": true, "no-export-subconfed": true, "no-peer": true,
+}
+
+// ValidateBGPCommunity implements the bgpCommunity validator.  It returns an error if community is not a standard
+// community of the form "aa:nn", where each part fits in 16 bits, a large community of the form "aa:nn:mm", where
+// each part fits in 32 bits, or the name of a well-known community such as "no-export".
+func ValidateBGPCommunity(community string) error {
+	if bgpWellKnownCommunities[community] {
+		return nil
+	}
+	parts := strings.Split(community, ":")
+	var bits int
+	switch len(parts) {
+	case 2:
+		bits = 16
+	case 3:
+		bits = 32
+	default:
+		return fmt.Errorf("%q is not a valid BGP community", community)
+	}
+	for _, part := range parts {
+		if part == "" || strings.TrimLeft(part, "0123456789") != "" {
+			return fmt.Errorf("%q is not a valid BGP community", community)
+		}
+		if _, err := strconv.ParseUint(part, 10, bits); err != nil {
+			return fmt.Errorf("%q is not a valid BGP community: %s does not fit in %d bits", community, part, bits)
+		}
+	}
+	return nil
+}
+
+// httpMethods are the request methods defined by RFC 7231, together with PATCH from RFC 5789.
+var httpMethods = map[string]bool{
+	"GET": true, "HEAD": true, "POST": true, "PUT": true, "DELETE": true, "CONNECT": true, "OPTIONS": true,
+	"TRACE": true, "PATCH": true,
+}
+
+// ValidateHTTPMethod implements the httpMethod validator.  It returns an error if method is not one of the HTTP
+// request methods defined by RFC 7231, or PATCH.  Method names are case-sensitive.
+func ValidateHTTPMethod(method string) error {
+	if !httpMethods[method] {
+		return fmt.Errorf("%q is not a valid HTTP method", method)
+	}
+	return nil
+}
+
+// dnsLabelRegex matches a single DNS label: up to 63 alphanumerics and hyphens that neither start nor end with a
+// hyphen.
+var dnsLabelRegex = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9]{0,61}[A-Za-z0-9])?$`)
+
+// ValidateDNSName implements the dnsName validator.  It returns an error if name is not a domain name of at most 253
+// characters, optionally fully qualified with a trailing dot, or such a name preceded by a "*." wildcard, for example
+// "*.example.com".
+func ValidateDNSName(name string) error {
+	domain := strings.TrimSuffix(strings.TrimPrefix(name, "*."), ".")
+	if domain == "" || len(domain) > 253 {
+		return fmt.Errorf("%q is not a valid DNS name", name)
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if !dnsLabelRegex.MatchString(label) {
+			return fmt.Errorf("%q is not a valid DNS name", name)
+		}
+	}
+	return nil
+}
+
+// ValidatePortName implements the portName validator.  It returns an error if name is not a valid named port: a DNS
+// label of at most 15 characters that contains at least one letter, as accepted by numorstring.NamedPortOrNumber.
+func ValidatePortName(name string) error {
+	p, err := numorstring.NamedPortOrNumberFromString(name)
+	if err != nil {
+		return err
+	}
+	if p.Type != numorstring.NumOrStringString {
+		return fmt.Errorf("%q is a port number rather than a port name", name)
+	}
+	return nil
+}
+
+var (
+	// namespaceRegex matches a Kubernetes namespace name, which is a lower case RFC 1123 label.
+	namespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	// resourceNameRegex matches a Kubernetes resource name, which is a lower case RFC 1123 subdomain.
+	resourceNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidatePolicyName implements the policyName validator.  It returns an error if name is not a policy name, for a
+// global policy, or a namespace and policy name separated by a "/", for a namespaced policy.
+func ValidatePolicyName(name string) error {
+	policy := name
+	if parts := strings.SplitN(name, "/", 2); len(parts) == 2 {
+		if !namespaceRegex.MatchString(parts[0]) {
+			return fmt.Errorf("%q does not have a valid namespace", name)
+		}
+		policy = parts[1]
+	}
+	if len(policy) > 253 || !resourceNameRegex.MatchString(policy) {
+		return fmt.Errorf("%q is not a valid policy name", name)
+	}
+	return nil
+}
+
+// ValidateExpectedIPs implements the expectedIPs validator.  It returns an error if any of ips is not a plain IPv4 or
+// IPv6 address.  A CIDR would never match an interface IP, so it is rejected rather than silently ignored.
+func ValidateExpectedIPs(ips []string) error {
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("expected IP %q is not a valid IP address", ip)
+		}
+	}
+	return nil
+}
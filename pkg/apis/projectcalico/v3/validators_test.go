@@ -0,0 +1,185 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	. "github.com/tigera/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = DescribeTable("ValidateHTTPHeaderName",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidateHTTPHeaderName(name), expectErr)
+	},
+	Entry("request ID header", "X-Request-ID", false),
+	Entry("B3 trace header", "X-B3-TraceId", false),
+	Entry("token punctuation", "x!#$%&'*+.^_`|~", false),
+	Entry("empty", "", true),
+	Entry("space", "X Request ID", true),
+	Entry("colon", "X-Request-ID:", true),
+	Entry("non-ASCII", "X-Requëst-ID", true),
+)
+
+var _ = DescribeTable("ValidateBPFProgramName",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidateBPFProgramName(name), expectErr)
+	},
+	Entry("workload program", "cali_from_wep", false),
+	Entry("name with a dot", "cali_tc.preamb", false),
+	Entry("15 characters", "abcdefghijklmno", false),
+	Entry("empty", "", true),
+	Entry("16 characters", "abcdefghijklmnop", true),
+	Entry("hyphen", "cali-from-wep", true),
+	Entry("space", "cali from", true),
+)
+
+var _ = DescribeTable("ValidateBPFFilter",
+	func(filter string, expectErr bool) {
+		expectValidationResult(ValidateBPFFilter(filter), expectErr)
+	},
+	Entry("single primitive", "tcp", false),
+	Entry("primitive with qualifiers", "src host 10.0.0.1", false),
+	Entry("logical operators", "tcp port 80 and not host 10.0.0.1", false),
+	Entry("symbolic operators", "udp && !(port 53 || port 5353)", false),
+	Entry("nested parentheses", "(tcp and (port 80 or port 443)) or icmp", false),
+	Entry("comparison", "ip[8] != 64 and len > 100", false),
+	Entry("empty", "", true),
+	Entry("whitespace only", "   ", true),
+	Entry("control character", "tcp\nport 80", true),
+	Entry("leading operator", "and tcp", true),
+	Entry("trailing operator", "tcp and", true),
+	Entry("trailing not", "tcp and not", true),
+	Entry("empty parentheses", "tcp and ()", true),
+	Entry("unclosed parenthesis", "(tcp or udp", true),
+	Entry("unopened parenthesis", "tcp or udp)", true),
+)
+
+var _ = DescribeTable("ValidateTLSCipherSuite",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidateTLSCipherSuite(name), expectErr)
+	},
+	Entry("TLS 1.2 suite", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", false),
+	Entry("TLS 1.3 suite", "TLS_AES_256_GCM_SHA384", false),
+	Entry("insecure suite", "TLS_RSA_WITH_RC4_128_SHA", false),
+	Entry("empty", "", true),
+	Entry("lower case", "tls_ecdhe_rsa_with_aes_128_gcm_sha256", true),
+	Entry("unknown suite", "TLS_ECDHE_RSA_WITH_AES_512_GCM_SHA256", true),
+)
+
+var _ = DescribeTable("ValidateCronSchedule",
+	func(schedule string, expectErr bool) {
+		expectValidationResult(ValidateCronSchedule(schedule), expectErr)
+	},
+	Entry("weekly at 02:00", "0 2 * * 0", false),
+	Entry("every minute", "* * * * *", false),
+	Entry("lists, ranges and steps", "0,30 9-17/2 1-15 */3 1-5", false),
+	Entry("month and day names", "15 3 * jan-MAR SUN,sat", false),
+	Entry("Sunday as 7", "0 0 * * 7", false),
+	Entry("descriptor", "@daily", false),
+	Entry("empty", "", true),
+	Entry("too few fields", "0 2 * *", true),
+	Entry("too many fields", "0 0 2 * * 0", true),
+	Entry("minute out of range", "60 * * * *", true),
+	Entry("day of month zero", "0 0 0 * *", true),
+	Entry("reversed range", "0 17-9 * * *", true),
+	Entry("zero step", "*/0 * * * *", true),
+	Entry("name in the wrong field", "0 0 * MON *", true),
+	Entry("unknown descriptor", "@fortnightly", true),
+)
+
+var _ = DescribeTable("ValidateBGPCommunity",
+	func(community string, expectErr bool) {
+		expectValidationResult(ValidateBGPCommunity(community), expectErr)
+	},
+	Entry("standard community", "65001:100", false),
+	Entry("standard community at the limits", "65535:65535", false),
+	Entry("large community", "4200000000:1:4294967295", false),
+	Entry("well-known community name", "no-export", false),
+	Entry("well-known community value", BGPCommunityNoExport, false),
+	Entry("empty", "", true),
+	Entry("single number", "65001", true),
+	Entry("too many parts", "1:2:3:4", true),
+	Entry("empty part", "65001:", true),
+	Entry("signed part", "+1:100", true),
+	Entry("standard community part too large", "65536:100", true),
+	Entry("large community part too large", "4294967296:1:1", true),
+	Entry("unknown name", "no-such-community", true),
+)
+
+var _ = DescribeTable("ValidateHTTPMethod",
+	func(method string, expectErr bool) {
+		expectValidationResult(ValidateHTTPMethod(method), expectErr)
+	},
+	Entry("GET", "GET", false),
+	Entry("CONNECT", "CONNECT", false),
+	Entry("PATCH", "PATCH", false),
+	Entry("empty", "", true),
+	Entry("lower case", "get", true),
+	Entry("unknown method", "PURGE", true),
+)
+
+var _ = DescribeTable("ValidateDNSName",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidateDNSName(name), expectErr)
+	},
+	Entry("domain name", "api.example.com", false),
+	Entry("fully qualified name", "api.example.com.", false),
+	Entry("single label", "localhost", false),
+	Entry("wildcard", "*.example.com", false),
+	Entry("hyphenated label", "my-service.example.com", false),
+	Entry("63 character label", strings.Repeat("a", 63)+".com", false),
+	Entry("empty", "", true),
+	Entry("bare wildcard", "*", true),
+	Entry("wildcard that is not leading", "api.*.example.com", true),
+	Entry("partial wildcard", "api*.example.com", true),
+	Entry("empty label", "api..example.com", true),
+	Entry("leading hyphen", "-api.example.com", true),
+	Entry("underscore", "my_service.example.com", true),
+	Entry("64 character label", strings.Repeat("a", 64)+".com", true),
+	Entry("name longer than 253 characters", strings.Repeat(strings.Repeat("a", 63)+".", 4)+"com", true),
+)
+
+var _ = DescribeTable("ValidatePortName",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidatePortName(name), expectErr)
+	},
+	Entry("simple name", "http", false),
+	Entry("name with digits and hyphens", "metrics-1", false),
+	Entry("15 characters", "abcdefghijklmno", false),
+	Entry("empty", "", true),
+	Entry("port number", "8080", true),
+	Entry("16 characters", "abcdefghijklmnop", true),
+	Entry("upper case", "HTTP", true),
+	Entry("leading hyphen", "-http", true),
+	Entry("underscore", "http_alt", true),
+)
+
+var _ = DescribeTable("ValidatePolicyName",
+	func(name string, expectErr bool) {
+		expectValidationResult(ValidatePolicyName(name), expectErr)
+	},
+	Entry("global policy", "allow-dns", false),
+	Entry("tiered policy", "security.allow-dns", false),
+	Entry("namespaced policy", "kube-system/allow-dns", false),
+	Entry("empty", "", true),
+	Entry("upper case", "Allow-DNS", true),
+	Entry("empty namespace", "/allow-dns", true),
+	Entry("empty policy name", "kube-system/", true),
+	Entry("two separators", "a/b/c", true),
+	Entry("namespace with a dot", "kube.system/allow-dns", true),
+)
@@ -15,6 +15,90 @@ import (
 	audit "k8s.io/apiserver/pkg/apis/audit"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertException) DeepCopyInto(out *AlertException) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertException.
+func (in *AlertException) DeepCopy() *AlertException {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertException) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertExceptionList) DeepCopyInto(out *AlertExceptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertExceptionList.
+func (in *AlertExceptionList) DeepCopy() *AlertExceptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertExceptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertExceptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertExceptionSpec) DeepCopyInto(out *AlertExceptionSpec) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertExceptionSpec.
+func (in *AlertExceptionSpec) DeepCopy() *AlertExceptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertExceptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditEventsSelection) DeepCopyInto(out *AuditEventsSelection) {
 	*out = *in
@@ -84,6 +168,27 @@ func (in *AutoHostEndpointConfig) DeepCopy() *AutoHostEndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPCommunityAdvertisement) DeepCopyInto(out *BGPCommunityAdvertisement) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPCommunityAdvertisement.
+func (in *BGPCommunityAdvertisement) DeepCopy() *BGPCommunityAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPCommunityAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPConfiguration) DeepCopyInto(out *BGPConfiguration) {
 	*out = *in
@@ -191,6 +296,13 @@ func (in *BGPConfigurationSpec) DeepCopyInto(out *BGPConfigurationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CommunityAdvertisements != nil {
+		in, out := &in.CommunityAdvertisements, &out.CommunityAdvertisements
+		*out = make([]BGPCommunityAdvertisement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -204,6 +316,108 @@ func (in *BGPConfigurationSpec) DeepCopy() *BGPConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilter) DeepCopyInto(out *BGPFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilter.
+func (in *BGPFilter) DeepCopy() *BGPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterList) DeepCopyInto(out *BGPFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterList.
+func (in *BGPFilterList) DeepCopy() *BGPFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRule) DeepCopyInto(out *BGPFilterRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRule.
+func (in *BGPFilterRule) DeepCopy() *BGPFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSpec) DeepCopyInto(out *BGPFilterSpec) {
+	*out = *in
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSpec.
+func (in *BGPFilterSpec) DeepCopy() *BGPFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPassword) DeepCopyInto(out *BGPPassword) {
 	*out = *in
@@ -305,6 +519,26 @@ func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.GracefulRestartTime != nil {
+		in, out := &in.GracefulRestartTime, &out.GracefulRestartTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LongLivedGracefulRestartEnabled != nil {
+		in, out := &in.LongLivedGracefulRestartEnabled, &out.LongLivedGracefulRestartEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LongLivedGracefulRestartTime != nil {
+		in, out := &in.LongLivedGracefulRestartTime, &out.LongLivedGracefulRestartTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -519,6 +753,7 @@ func (in *ClusterInformation) DeepCopyInto(out *ClusterInformation) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -594,6 +829,23 @@ func (in *ClusterInformationSpec) DeepCopy() *ClusterInformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInformationStatus) DeepCopyInto(out *ClusterInformationStatus) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInformationStatus.
+func (in *ClusterInformationStatus) DeepCopy() *ClusterInformationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInformationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Community) DeepCopyInto(out *Community) {
 	*out = *in
@@ -746,7 +998,7 @@ func (in *DeepPacketInspection) DeepCopyInto(out *DeepPacketInspection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -805,6 +1057,21 @@ func (in *DeepPacketInspectionList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeepPacketInspectionSpec) DeepCopyInto(out *DeepPacketInspectionSpec) {
 	*out = *in
+	if in.EnabledApplicationLayers != nil {
+		in, out := &in.EnabledApplicationLayers, &out.EnabledApplicationLayers
+		*out = make([]DPIApplicationLayer, len(*in))
+		copy(*out, *in)
+	}
+	if in.ThreatFeedRefs != nil {
+		in, out := &in.ThreatFeedRefs, &out.ThreatFeedRefs
+		*out = make([]ThreatFeedRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPacketInspectionDepth != nil {
+		in, out := &in.MaxPacketInspectionDepth, &out.MaxPacketInspectionDepth
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -831,12 +1098,140 @@ func (in *DeepPacketInspectionStatus) DeepCopyInto(out *DeepPacketInspectionStat
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeepPacketInspectionStatus.
-func (in *DeepPacketInspectionStatus) DeepCopy() *DeepPacketInspectionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeepPacketInspectionStatus.
+func (in *DeepPacketInspectionStatus) DeepCopy() *DeepPacketInspectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeepPacketInspectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGateway) DeepCopyInto(out *EgressGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGateway.
+func (in *EgressGateway) DeepCopy() *EgressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayIPPool) DeepCopyInto(out *EgressGatewayIPPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayIPPool.
+func (in *EgressGatewayIPPool) DeepCopy() *EgressGatewayIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayList) DeepCopyInto(out *EgressGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EgressGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayList.
+func (in *EgressGatewayList) DeepCopy() *EgressGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayRef) DeepCopyInto(out *EgressGatewayRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayRef.
+func (in *EgressGatewayRef) DeepCopy() *EgressGatewayRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewaySpec) DeepCopyInto(out *EgressGatewaySpec) {
+	*out = *in
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]EgressGatewayIPPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownDelay != nil {
+		in, out := &in.ScaleDownDelay, &out.ScaleDownDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewaySpec.
+func (in *EgressGatewaySpec) DeepCopy() *EgressGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeepPacketInspectionStatus)
+	out := new(EgressGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1013,6 +1408,11 @@ func (in *EntityRule) DeepCopyInto(out *EntityRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NotNets != nil {
 		in, out := &in.NotNets, &out.NotNets
 		*out = make([]string, len(*in))
@@ -1073,6 +1473,42 @@ func (in *EtcdConfig) DeepCopy() *EtcdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureDetectOverrides) DeepCopyInto(out *FeatureDetectOverrides) {
+	*out = *in
+	if in.SNATFullyRandom != nil {
+		in, out := &in.SNATFullyRandom, &out.SNATFullyRandom
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MASQFullyRandom != nil {
+		in, out := &in.MASQFullyRandom, &out.MASQFullyRandom
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreSupportsLock != nil {
+		in, out := &in.RestoreSupportsLock, &out.RestoreSupportsLock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BPFKTimeGetNSSupported != nil {
+		in, out := &in.BPFKTimeGetNSSupported, &out.BPFKTimeGetNSSupported
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureDetectOverrides.
+func (in *FeatureDetectOverrides) DeepCopy() *FeatureDetectOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureDetectOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FederatedServicesControllerConfig) DeepCopyInto(out *FederatedServicesControllerConfig) {
 	*out = *in
@@ -1177,6 +1613,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.InterfaceRefreshIntervalOnError != nil {
+		in, out := &in.InterfaceRefreshIntervalOnError, &out.InterfaceRefreshIntervalOnError
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ArpRefreshInterval != nil {
+		in, out := &in.ArpRefreshInterval, &out.ArpRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.IptablesRefreshInterval != nil {
 		in, out := &in.IptablesRefreshInterval, &out.IptablesRefreshInterval
 		*out = new(metav1.Duration)
@@ -1197,6 +1643,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.FeatureDetectOverrides != nil {
+		in, out := &in.FeatureDetectOverrides, &out.FeatureDetectOverrides
+		*out = new(FeatureDetectOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IpsetsRefreshInterval != nil {
 		in, out := &in.IpsetsRefreshInterval, &out.IpsetsRefreshInterval
 		*out = new(metav1.Duration)
@@ -1207,6 +1658,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaxIpsetSoftLimit != nil {
+		in, out := &in.MaxIpsetSoftLimit, &out.MaxIpsetSoftLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxIpsetHardLimit != nil {
+		in, out := &in.MaxIpsetHardLimit, &out.MaxIpsetHardLimit
+		*out = new(int)
+		**out = **in
+	}
 	if in.IptablesBackend != nil {
 		in, out := &in.IptablesBackend, &out.IptablesBackend
 		*out = new(IptablesBackend)
@@ -1222,11 +1683,30 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NetlinkGetTimeout != nil {
+		in, out := &in.NetlinkGetTimeout, &out.NetlinkGetTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NetlinkSetTimeout != nil {
+		in, out := &in.NetlinkSetTimeout, &out.NetlinkSetTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.MetadataPort != nil {
 		in, out := &in.MetadataPort, &out.MetadataPort
 		*out = new(int)
 		**out = **in
 	}
+	if in.InterfacePrefixes != nil {
+		in, out := &in.InterfacePrefixes, &out.InterfacePrefixes
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.LogDropActionOverride != nil {
 		in, out := &in.LogDropActionOverride, &out.LogDropActionOverride
 		*out = new(bool)
@@ -1242,6 +1722,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.IPIPMTUIPv6 != nil {
+		in, out := &in.IPIPMTUIPv6, &out.IPIPMTUIPv6
+		*out = new(int)
+		**out = **in
+	}
 	if in.VXLANEnabled != nil {
 		in, out := &in.VXLANEnabled, &out.VXLANEnabled
 		*out = new(bool)
@@ -1262,6 +1747,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.VXLANMTUIPv6 != nil {
+		in, out := &in.VXLANMTUIPv6, &out.VXLANMTUIPv6
+		*out = new(int)
+		**out = **in
+	}
 	if in.AllowVXLANPacketsFromWorkloads != nil {
 		in, out := &in.AllowVXLANPacketsFromWorkloads, &out.AllowVXLANPacketsFromWorkloads
 		*out = new(bool)
@@ -1317,6 +1807,26 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.HealthCheckTimeout != nil {
+		in, out := &in.HealthCheckTimeout, &out.HealthCheckTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HealthTimeoutOverrides != nil {
+		in, out := &in.HealthTimeoutOverrides, &out.HealthTimeoutOverrides
+		*out = make(map[string]*metav1.Duration, len(*in))
+		for key, val := range *in {
+			var outVal *metav1.Duration
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(metav1.Duration)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.PrometheusMetricsEnabled != nil {
 		in, out := &in.PrometheusMetricsEnabled, &out.PrometheusMetricsEnabled
 		*out = new(bool)
@@ -1342,13 +1852,24 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PrometheusMetricsTLSCipherSuites != nil {
+		in, out := &in.PrometheusMetricsTLSCipherSuites, &out.PrometheusMetricsTLSCipherSuites
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.FailsafeInboundHostPorts != nil {
 		in, out := &in.FailsafeInboundHostPorts, &out.FailsafeInboundHostPorts
 		*out = new([]ProtoPort)
 		if **in != nil {
 			in, out := *in, *out
 			*out = make([]ProtoPort, len(*in))
-			copy(*out, *in)
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
 		}
 	}
 	if in.FailsafeOutboundHostPorts != nil {
@@ -1357,7 +1878,9 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		if **in != nil {
 			in, out := *in, *out
 			*out = make([]ProtoPort, len(*in))
-			copy(*out, *in)
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
 		}
 	}
 	if in.KubeMasqueradeBit != nil {
@@ -1404,6 +1927,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ECMPEnabled != nil {
+		in, out := &in.ECMPEnabled, &out.ECMPEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ExternalNodesCIDRList != nil {
 		in, out := &in.ExternalNodesCIDRList, &out.ExternalNodesCIDRList
 		*out = new([]string)
@@ -1443,6 +1971,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.DebugCPUProfileInterval != nil {
+		in, out := &in.DebugCPUProfileInterval, &out.DebugCPUProfileInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DebugPanicOnWriteFailure != nil {
+		in, out := &in.DebugPanicOnWriteFailure, &out.DebugPanicOnWriteFailure
+		*out = new(bool)
+		**out = **in
+	}
 	if in.SidecarAccelerationEnabled != nil {
 		in, out := &in.SidecarAccelerationEnabled, &out.SidecarAccelerationEnabled
 		*out = new(bool)
@@ -1468,11 +2006,67 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BPFLogFilters != nil {
+		in, out := &in.BPFLogFilters, &out.BPFLogFilters
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.BPFInterfaceLogFilters != nil {
+		in, out := &in.BPFInterfaceLogFilters, &out.BPFInterfaceLogFilters
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.BPFPolicyDebugEnabled != nil {
+		in, out := &in.BPFPolicyDebugEnabled, &out.BPFPolicyDebugEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BPFPolicyDebugSamplingRate != nil {
+		in, out := &in.BPFPolicyDebugSamplingRate, &out.BPFPolicyDebugSamplingRate
+		*out = new(int)
+		**out = **in
+	}
 	if in.BPFConnectTimeLoadBalancingEnabled != nil {
 		in, out := &in.BPFConnectTimeLoadBalancingEnabled, &out.BPFConnectTimeLoadBalancingEnabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BPFCTLBHeartbeatEnabled != nil {
+		in, out := &in.BPFCTLBHeartbeatEnabled, &out.BPFCTLBHeartbeatEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BPFCTLBHeartbeatInterval != nil {
+		in, out := &in.BPFCTLBHeartbeatInterval, &out.BPFCTLBHeartbeatInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BPFHostConntrackBypass != nil {
+		in, out := &in.BPFHostConntrackBypass, &out.BPFHostConntrackBypass
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BPFExcludeCIDRsFromNAT != nil {
+		in, out := &in.BPFExcludeCIDRsFromNAT, &out.BPFExcludeCIDRsFromNAT
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.BPFExtToServiceConnmark != nil {
 		in, out := &in.BPFExtToServiceConnmark, &out.BPFExtToServiceConnmark
 		*out = new(int)
@@ -1493,21 +2087,99 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BPFKubeProxyEndpointHealthzPort != nil {
+		in, out := &in.BPFKubeProxyEndpointHealthzPort, &out.BPFKubeProxyEndpointHealthzPort
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFKubeProxyNodePortRanges != nil {
+		in, out := &in.BPFKubeProxyNodePortRanges, &out.BPFKubeProxyNodePortRanges
+		*out = new([]numorstring.Port)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]numorstring.Port, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.BPFForceTrackPacketsFromIfaces != nil {
+		in, out := &in.BPFForceTrackPacketsFromIfaces, &out.BPFForceTrackPacketsFromIfaces
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.BPFMapSizeConntrack != nil {
+		in, out := &in.BPFMapSizeConntrack, &out.BPFMapSizeConntrack
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeNATFrontend != nil {
+		in, out := &in.BPFMapSizeNATFrontend, &out.BPFMapSizeNATFrontend
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeNATBackend != nil {
+		in, out := &in.BPFMapSizeNATBackend, &out.BPFMapSizeNATBackend
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeNATAffinity != nil {
+		in, out := &in.BPFMapSizeNATAffinity, &out.BPFMapSizeNATAffinity
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeRoute != nil {
+		in, out := &in.BPFMapSizeRoute, &out.BPFMapSizeRoute
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFCTMaxTrackedConnections != nil {
+		in, out := &in.BPFCTMaxTrackedConnections, &out.BPFCTMaxTrackedConnections
+		*out = new(int)
+		**out = **in
+	}
 	if in.IPSecAllowUnsecuredTraffic != nil {
 		in, out := &in.IPSecAllowUnsecuredTraffic, &out.IPSecAllowUnsecuredTraffic
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IPSecStrictMode != nil {
+		in, out := &in.IPSecStrictMode, &out.IPSecStrictMode
+		*out = new(bool)
+		**out = **in
+	}
 	if in.IPSecPolicyRefreshInterval != nil {
 		in, out := &in.IPSecPolicyRefreshInterval, &out.IPSecPolicyRefreshInterval
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.IPSecReplayWindowSize != nil {
+		in, out := &in.IPSecReplayWindowSize, &out.IPSecReplayWindowSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.IPSecDPDTimeout != nil {
+		in, out := &in.IPSecDPDTimeout, &out.IPSecDPDTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.FlowLogsFlushInterval != nil {
 		in, out := &in.FlowLogsFlushInterval, &out.FlowLogsFlushInterval
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.FlowLogsMaxBytesPerFlush != nil {
+		in, out := &in.FlowLogsMaxBytesPerFlush, &out.FlowLogsMaxBytesPerFlush
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsExportBatchTimeout != nil {
+		in, out := &in.FlowLogsExportBatchTimeout, &out.FlowLogsExportBatchTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.FlowLogsEnableHostEndpoint != nil {
 		in, out := &in.FlowLogsEnableHostEndpoint, &out.FlowLogsEnableHostEndpoint
 		*out = new(bool)
@@ -1518,6 +2190,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FlowLogsDNSAggregationEnabled != nil {
+		in, out := &in.FlowLogsDNSAggregationEnabled, &out.FlowLogsDNSAggregationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsEnableDNSPolicyViolation != nil {
+		in, out := &in.FlowLogsEnableDNSPolicyViolation, &out.FlowLogsEnableDNSPolicyViolation
+		*out = new(bool)
+		**out = **in
+	}
 	if in.FlowLogsMaxOriginalIPsIncluded != nil {
 		in, out := &in.FlowLogsMaxOriginalIPsIncluded, &out.FlowLogsMaxOriginalIPsIncluded
 		*out = new(int)
@@ -1553,6 +2235,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.FlowLogsFileCompressionEnabled != nil {
+		in, out := &in.FlowLogsFileCompressionEnabled, &out.FlowLogsFileCompressionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsFileCompressionLevel != nil {
+		in, out := &in.FlowLogsFileCompressionLevel, &out.FlowLogsFileCompressionLevel
+		*out = new(int)
+		**out = **in
+	}
 	if in.FlowLogsFileDirectory != nil {
 		in, out := &in.FlowLogsFileDirectory, &out.FlowLogsFileDirectory
 		*out = new(string)
@@ -1568,11 +2260,30 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FlowLogsPolicyFields != nil {
+		in, out := &in.FlowLogsPolicyFields, &out.FlowLogsPolicyFields
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.FlowLogsFileIncludeService != nil {
 		in, out := &in.FlowLogsFileIncludeService, &out.FlowLogsFileIncludeService
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FlowLogsFileIncludeProcess != nil {
+		in, out := &in.FlowLogsFileIncludeProcess, &out.FlowLogsFileIncludeProcess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxProcessNameLength != nil {
+		in, out := &in.FlowLogsFileMaxProcessNameLength, &out.FlowLogsFileMaxProcessNameLength
+		*out = new(int)
+		**out = **in
+	}
 	if in.FlowLogsFileAggregationKindForAllowed != nil {
 		in, out := &in.FlowLogsFileAggregationKindForAllowed, &out.FlowLogsFileAggregationKindForAllowed
 		*out = new(int)
@@ -1613,6 +2324,41 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.FlowLogsS3Enabled != nil {
+		in, out := &in.FlowLogsS3Enabled, &out.FlowLogsS3Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsS3AggregationKindForAllowed != nil {
+		in, out := &in.FlowLogsS3AggregationKindForAllowed, &out.FlowLogsS3AggregationKindForAllowed
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsS3AggregationKindForDenied != nil {
+		in, out := &in.FlowLogsS3AggregationKindForDenied, &out.FlowLogsS3AggregationKindForDenied
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsS3EnabledForAllowed != nil {
+		in, out := &in.FlowLogsS3EnabledForAllowed, &out.FlowLogsS3EnabledForAllowed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsS3EnabledForDenied != nil {
+		in, out := &in.FlowLogsS3EnabledForDenied, &out.FlowLogsS3EnabledForDenied
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WindowsFlowLogsMaxFileSizeMB != nil {
+		in, out := &in.WindowsFlowLogsMaxFileSizeMB, &out.WindowsFlowLogsMaxFileSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.WindowsFlowLogsMaxFiles != nil {
+		in, out := &in.WindowsFlowLogsMaxFiles, &out.WindowsFlowLogsMaxFiles
+		*out = new(int)
+		**out = **in
+	}
 	if in.WindowsDNSExtraTTL != nil {
 		in, out := &in.WindowsDNSExtraTTL, &out.WindowsDNSExtraTTL
 		*out = new(metav1.Duration)
@@ -1662,6 +2408,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.DNSLogsFileCompressionEnabled != nil {
+		in, out := &in.DNSLogsFileCompressionEnabled, &out.DNSLogsFileCompressionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DNSLogsFileDirectory != nil {
 		in, out := &in.DNSLogsFileDirectory, &out.DNSLogsFileDirectory
 		*out = new(string)
@@ -1682,6 +2433,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.DNSLogsS3Enabled != nil {
+		in, out := &in.DNSLogsS3Enabled, &out.DNSLogsS3Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSLogsS3PerNodeLimit != nil {
+		in, out := &in.DNSLogsS3PerNodeLimit, &out.DNSLogsS3PerNodeLimit
+		*out = new(int)
+		**out = **in
+	}
 	if in.DNSLogsLatency != nil {
 		in, out := &in.DNSLogsLatency, &out.DNSLogsLatency
 		*out = new(bool)
@@ -1707,6 +2468,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.L7LogsFileCompressionEnabled != nil {
+		in, out := &in.L7LogsFileCompressionEnabled, &out.L7LogsFileCompressionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.L7LogsFileDirectory != nil {
 		in, out := &in.L7LogsFileDirectory, &out.L7LogsFileDirectory
 		*out = new(string)
@@ -1747,24 +2513,59 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.L7LogsFileAggregationNumURLPath != nil {
-		in, out := &in.L7LogsFileAggregationNumURLPath, &out.L7LogsFileAggregationNumURLPath
+	if in.L7LogsFileAggregationNumURLPath != nil {
+		in, out := &in.L7LogsFileAggregationNumURLPath, &out.L7LogsFileAggregationNumURLPath
+		*out = new(int)
+		**out = **in
+	}
+	if in.L7LogsFileAggregationURLCharLimit != nil {
+		in, out := &in.L7LogsFileAggregationURLCharLimit, &out.L7LogsFileAggregationURLCharLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.L7LogsFilePerNodeLimit != nil {
+		in, out := &in.L7LogsFilePerNodeLimit, &out.L7LogsFilePerNodeLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.L7LogsS3Enabled != nil {
+		in, out := &in.L7LogsS3Enabled, &out.L7LogsS3Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.L7LogsElasticsearchEnabled != nil {
+		in, out := &in.L7LogsElasticsearchEnabled, &out.L7LogsElasticsearchEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WindowsNetworkName != nil {
+		in, out := &in.WindowsNetworkName, &out.WindowsNetworkName
+		*out = new(string)
+		**out = **in
+	}
+	if in.WindowsVXLANEnabled != nil {
+		in, out := &in.WindowsVXLANEnabled, &out.WindowsVXLANEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WindowsVXLANMTU != nil {
+		in, out := &in.WindowsVXLANMTU, &out.WindowsVXLANMTU
 		*out = new(int)
 		**out = **in
 	}
-	if in.L7LogsFileAggregationURLCharLimit != nil {
-		in, out := &in.L7LogsFileAggregationURLCharLimit, &out.L7LogsFileAggregationURLCharLimit
+	if in.WindowsVXLANPort != nil {
+		in, out := &in.WindowsVXLANPort, &out.WindowsVXLANPort
 		*out = new(int)
 		**out = **in
 	}
-	if in.L7LogsFilePerNodeLimit != nil {
-		in, out := &in.L7LogsFilePerNodeLimit, &out.L7LogsFilePerNodeLimit
+	if in.WindowsVXLANVNI != nil {
+		in, out := &in.WindowsVXLANVNI, &out.WindowsVXLANVNI
 		*out = new(int)
 		**out = **in
 	}
-	if in.WindowsNetworkName != nil {
-		in, out := &in.WindowsNetworkName, &out.WindowsNetworkName
-		*out = new(string)
+	if in.WindowsBPFEnabled != nil {
+		in, out := &in.WindowsBPFEnabled, &out.WindowsBPFEnabled
+		*out = new(bool)
 		**out = **in
 	}
 	if in.RouteTableRange != nil {
@@ -1772,6 +2573,15 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(RouteTableRange)
 		**out = **in
 	}
+	if in.RouteTableRanges != nil {
+		in, out := &in.RouteTableRanges, &out.RouteTableRanges
+		*out = new([]RouteTableRange)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]RouteTableRange, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.EgressIPVXLANPort != nil {
 		in, out := &in.EgressIPVXLANPort, &out.EgressIPVXLANPort
 		*out = new(int)
@@ -1787,6 +2597,21 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.EgressIPHealthTimeoutDatastore != nil {
+		in, out := &in.EgressIPHealthTimeoutDatastore, &out.EgressIPHealthTimeoutDatastore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EgressIPHealthTimeoutICMP != nil {
+		in, out := &in.EgressIPHealthTimeoutICMP, &out.EgressIPHealthTimeoutICMP
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EgressIPVXLANMTU != nil {
+		in, out := &in.EgressIPVXLANMTU, &out.EgressIPVXLANMTU
+		*out = new(int)
+		**out = **in
+	}
 	if in.WireguardEnabled != nil {
 		in, out := &in.WireguardEnabled, &out.WireguardEnabled
 		*out = new(bool)
@@ -1797,6 +2622,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.WireguardEnabledV6 != nil {
+		in, out := &in.WireguardEnabledV6, &out.WireguardEnabledV6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WireguardListeningPortV6 != nil {
+		in, out := &in.WireguardListeningPortV6, &out.WireguardListeningPortV6
+		*out = new(int)
+		**out = **in
+	}
 	if in.WireguardRoutingRulePriority != nil {
 		in, out := &in.WireguardRoutingRulePriority, &out.WireguardRoutingRulePriority
 		*out = new(int)
@@ -1812,6 +2647,20 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.WireguardAllowedCIDRs != nil {
+		in, out := &in.WireguardAllowedCIDRs, &out.WireguardAllowedCIDRs
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.WireguardPersistentKeepAlive != nil {
+		in, out := &in.WireguardPersistentKeepAlive, &out.WireguardPersistentKeepAlive
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.CaptureDir != nil {
 		in, out := &in.CaptureDir, &out.CaptureDir
 		*out = new(string)
@@ -1832,16 +2681,64 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CaptureSnapLen != nil {
+		in, out := &in.CaptureSnapLen, &out.CaptureSnapLen
+		*out = new(int)
+		**out = **in
+	}
 	if in.AWSSrcDstCheck != nil {
 		in, out := &in.AWSSrcDstCheck, &out.AWSSrcDstCheck
 		*out = new(AWSSrcDstCheckOption)
 		**out = **in
 	}
+	if in.ServiceLoopPreventionExemptCIDRs != nil {
+		in, out := &in.ServiceLoopPreventionExemptCIDRs, &out.ServiceLoopPreventionExemptCIDRs
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.ServiceCIDRs != nil {
+		in, out := &in.ServiceCIDRs, &out.ServiceCIDRs
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.TPROXYPort != nil {
 		in, out := &in.TPROXYPort, &out.TPROXYPort
 		*out = new(int)
 		**out = **in
 	}
+	if in.TPROXYMark != nil {
+		in, out := &in.TPROXYMark, &out.TPROXYMark
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TPROXYMarkMask != nil {
+		in, out := &in.TPROXYMarkMask, &out.TPROXYMarkMask
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NfqueueEnabled != nil {
+		in, out := &in.NfqueueEnabled, &out.NfqueueEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NfqueueNumber != nil {
+		in, out := &in.NfqueueNumber, &out.NfqueueNumber
+		*out = new(int)
+		**out = **in
+	}
+	if in.NfqueueBypassForIntraNode != nil {
+		in, out := &in.NfqueueBypassForIntraNode, &out.NfqueueBypassForIntraNode
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1871,6 +2768,88 @@ func (in *FlowEndpoint) DeepCopy() *FlowEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedReport) DeepCopyInto(out *GeneratedReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedReport.
+func (in *GeneratedReport) DeepCopy() *GeneratedReport {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeneratedReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedReportList) DeepCopyInto(out *GeneratedReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GeneratedReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedReportList.
+func (in *GeneratedReportList) DeepCopy() *GeneratedReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeneratedReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedReportSpec) DeepCopyInto(out *GeneratedReportSpec) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.GenerationTime != nil {
+		in, out := &in.GenerationTime, &out.GenerationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedReportSpec.
+func (in *GeneratedReportSpec) DeepCopy() *GeneratedReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalAlert) DeepCopyInto(out *GlobalAlert) {
 	*out = *in
@@ -2143,6 +3122,21 @@ func (in *GlobalNetworkPolicySpec) DeepCopyInto(out *GlobalNetworkPolicySpec) {
 		*out = make([]PolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressGateway != nil {
+		in, out := &in.EgressGateway, &out.EgressGateway
+		*out = new(EgressGatewayRef)
+		**out = **in
+	}
+	if in.EndpointTypes != nil {
+		in, out := &in.EndpointTypes, &out.EndpointTypes
+		*out = make([]PolicyEndpointType, len(*in))
+		copy(*out, *in)
+	}
+	if in.StagedMode != nil {
+		in, out := &in.StagedMode, &out.StagedMode
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2414,6 +3408,27 @@ func (in *GlobalThreatFeed) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalThreatFeedAuth) DeepCopyInto(out *GlobalThreatFeedAuth) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalThreatFeedAuth.
+func (in *GlobalThreatFeedAuth) DeepCopy() *GlobalThreatFeedAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalThreatFeedAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalThreatFeedList) DeepCopyInto(out *GlobalThreatFeedList) {
 	*out = *in
@@ -2523,6 +3538,22 @@ func (in *HTTPHeader) DeepCopy() *HTTPHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPHeaderSource) DeepCopyInto(out *HTTPHeaderSource) {
 	*out = *in
@@ -2562,6 +3593,11 @@ func (in *HTTPMatch) DeepCopyInto(out *HTTPMatch) {
 		*out = make([]HTTPPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2602,6 +3638,16 @@ func (in *HTTPPull) DeepCopyInto(out *HTTPPull) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FetchTimeout != nil {
+		in, out := &in.FetchTimeout, &out.FetchTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(GlobalThreatFeedAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2693,6 +3739,16 @@ func (in *HostEndpointSpec) DeepCopyInto(out *HostEndpointSpec) {
 		*out = make([]EndpointPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.PreDNATPolicyNames != nil {
+		in, out := &in.PreDNATPolicyNames, &out.PreDNATPolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplyOnForward != nil {
+		in, out := &in.ApplyOnForward, &out.ApplyOnForward
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2727,29 +3783,140 @@ func (in *ICMPFields) DeepCopy() *ICMPFields {
 	if in == nil {
 		return nil
 	}
-	out := new(ICMPFields)
+	out := new(ICMPFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPIPConfiguration) DeepCopyInto(out *IPIPConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPIPConfiguration.
+func (in *IPIPConfiguration) DeepCopy() *IPIPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IPIPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
+func (in *IPPoolList) DeepCopy() *IPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPIPConfiguration) DeepCopyInto(out *IPIPConfiguration) {
+func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 	*out = *in
+	if in.DisableNewAllocations != nil {
+		in, out := &in.DisableNewAllocations, &out.DisableNewAllocations
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllocationTimeout != nil {
+		in, out := &in.AllocationTimeout, &out.AllocationTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ReservedBlocks != nil {
+		in, out := &in.ReservedBlocks, &out.ReservedBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BGPFilterRefs != nil {
+		in, out := &in.BGPFilterRefs, &out.BGPFilterRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedUses != nil {
+		in, out := &in.AllowedUses, &out.AllowedUses
+		*out = make([]IPPoolAllowedUse, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int)
+		**out = **in
+	}
+	if in.IPIP != nil {
+		in, out := &in.IPIP, &out.IPIP
+		*out = new(IPIPConfiguration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPIPConfiguration.
-func (in *IPIPConfiguration) DeepCopy() *IPIPConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
+func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPIPConfiguration)
+	out := new(IPPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPool) DeepCopyInto(out *IPPool) {
+func (in *IPReservation) DeepCopyInto(out *IPReservation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2757,18 +3924,18 @@ func (in *IPPool) DeepCopyInto(out *IPPool) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
-func (in *IPPool) DeepCopy() *IPPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPReservation.
+func (in *IPReservation) DeepCopy() *IPReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPool)
+	out := new(IPReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPPool) DeepCopyObject() runtime.Object {
+func (in *IPReservation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2776,13 +3943,13 @@ func (in *IPPool) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+func (in *IPReservationList) DeepCopyInto(out *IPReservationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IPPool, len(*in))
+		*out = make([]IPReservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2790,18 +3957,18 @@ func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
-func (in *IPPoolList) DeepCopy() *IPPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPReservationList.
+func (in *IPReservationList) DeepCopy() *IPReservationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPoolList)
+	out := new(IPReservationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPPoolList) DeepCopyObject() runtime.Object {
+func (in *IPReservationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2809,22 +3976,22 @@ func (in *IPPoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
+func (in *IPReservationSpec) DeepCopyInto(out *IPReservationSpec) {
 	*out = *in
-	if in.IPIP != nil {
-		in, out := &in.IPIP, &out.IPIP
-		*out = new(IPIPConfiguration)
-		**out = **in
+	if in.ReservedCIDRs != nil {
+		in, out := &in.ReservedCIDRs, &out.ReservedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
-func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPReservationSpec.
+func (in *IPReservationSpec) DeepCopy() *IPReservationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPoolSpec)
+	out := new(IPReservationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2919,6 +4086,26 @@ func (in *KubeControllersConfigurationSpec) DeepCopyInto(out *KubeControllersCon
 		*out = new(int)
 		**out = **in
 	}
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WorkqueueMaxRetries != nil {
+		in, out := &in.WorkqueueMaxRetries, &out.WorkqueueMaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.WorkqueueBaseDelay != nil {
+		in, out := &in.WorkqueueBaseDelay, &out.WorkqueueBaseDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WorkqueueMaxDelay != nil {
+		in, out := &in.WorkqueueMaxDelay, &out.WorkqueueMaxDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	in.Controllers.DeepCopyInto(&out.Controllers)
 	return
 }
@@ -3043,6 +4230,10 @@ func (in *LicenseKeyStatus) DeepCopyInto(out *LicenseKeyStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3061,7 +4252,7 @@ func (in *ManagedCluster) DeepCopyInto(out *ManagedCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -3120,6 +4311,21 @@ func (in *ManagedClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedClusterSpec) DeepCopyInto(out *ManagedClusterSpec) {
 	*out = *in
+	if in.ConnectionTimeout != nil {
+		in, out := &in.ConnectionTimeout, &out.ConnectionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -3299,6 +4505,11 @@ func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 		*out = make([]PolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.StagedMode != nil {
+		in, out := &in.StagedMode, &out.StagedMode
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3547,6 +4758,14 @@ func (in *PacketCaptureSpec) DeepCopyInto(out *PacketCaptureSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3604,6 +4823,111 @@ func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendation) DeepCopyInto(out *PolicyRecommendation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendation.
+func (in *PolicyRecommendation) DeepCopy() *PolicyRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyRecommendation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationList) DeepCopyInto(out *PolicyRecommendationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationList.
+func (in *PolicyRecommendationList) DeepCopy() *PolicyRecommendationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyRecommendationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationSpec) DeepCopyInto(out *PolicyRecommendationSpec) {
+	*out = *in
+	if in.StabilizationPeriod != nil {
+		in, out := &in.StabilizationPeriod, &out.StabilizationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationSpec.
+func (in *PolicyRecommendationSpec) DeepCopy() *PolicyRecommendationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationStatus) DeepCopyInto(out *PolicyRecommendationStatus) {
+	*out = *in
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make([]RecommendedPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationStatus.
+func (in *PolicyRecommendationStatus) DeepCopy() *PolicyRecommendationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixAdvertisement) DeepCopyInto(out *PrefixAdvertisement) {
 	*out = *in
@@ -3730,6 +5054,16 @@ func (in *ProfileSpec) DeepCopy() *ProfileSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProtoPort) DeepCopyInto(out *ProtoPort) {
 	*out = *in
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -3746,6 +5080,11 @@ func (in *ProtoPort) DeepCopy() *ProtoPort {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pull) DeepCopyInto(out *Pull) {
 	*out = *in
+	if in.PullInterval != nil {
+		in, out := &in.PullInterval, &out.PullInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPPull)
@@ -3764,6 +5103,23 @@ func (in *Pull) DeepCopy() *Pull {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendedPolicy) DeepCopyInto(out *RecommendedPolicy) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendedPolicy.
+func (in *RecommendedPolicy) DeepCopy() *RecommendedPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendedPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteClusterConfiguration) DeepCopyInto(out *RemoteClusterConfiguration) {
 	*out = *in
@@ -3909,6 +5265,27 @@ func (in *ReportData) DeepCopy() *ReportData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportInventoryConfig) DeepCopyInto(out *ReportInventoryConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AuditResource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportInventoryConfig.
+func (in *ReportInventoryConfig) DeepCopy() *ReportInventoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportInventoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportJob) DeepCopyInto(out *ReportJob) {
 	*out = *in
@@ -4042,6 +5419,21 @@ func (in *ReportTypeSpec) DeepCopyInto(out *ReportTypeSpec) {
 		*out = new(AuditEventsSelection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InventoryConfig != nil {
+		in, out := &in.InventoryConfig, &out.InventoryConfig
+		*out = new(ReportInventoryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EndpointsSelection != nil {
+		in, out := &in.EndpointsSelection, &out.EndpointsSelection
+		*out = new(EndpointsSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UIEnabled != nil {
+		in, out := &in.UIEnabled, &out.UIEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -4260,6 +5652,7 @@ func (in *StagedGlobalNetworkPolicy) DeepCopyInto(out *StagedGlobalNetworkPolicy
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
@@ -4341,6 +5734,16 @@ func (in *StagedGlobalNetworkPolicySpec) DeepCopyInto(out *StagedGlobalNetworkPo
 		*out = make([]PolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressGateway != nil {
+		in, out := &in.EgressGateway, &out.EgressGateway
+		*out = new(EgressGatewayRef)
+		**out = **in
+	}
+	if in.EndpointTypes != nil {
+		in, out := &in.EndpointTypes, &out.EndpointTypes
+		*out = make([]PolicyEndpointType, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4456,6 +5859,7 @@ func (in *StagedNetworkPolicy) DeepCopyInto(out *StagedNetworkPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
@@ -4550,6 +5954,22 @@ func (in *StagedNetworkPolicySpec) DeepCopy() *StagedNetworkPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedPolicyStatus) DeepCopyInto(out *StagedPolicyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedPolicyStatus.
+func (in *StagedPolicyStatus) DeepCopy() *StagedPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThreatFeedFormat) DeepCopyInto(out *ThreatFeedFormat) {
 	*out = *in
@@ -4634,6 +6054,22 @@ func (in *ThreatFeedFormatNewlineDelimited) DeepCopy() *ThreatFeedFormatNewlineD
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreatFeedRef) DeepCopyInto(out *ThreatFeedRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreatFeedRef.
+func (in *ThreatFeedRef) DeepCopy() *ThreatFeedRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreatFeedRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tier) DeepCopyInto(out *Tier) {
 	*out = *in
@@ -4702,6 +6138,11 @@ func (in *TierSpec) DeepCopyInto(out *TierSpec) {
 		*out = new(float64)
 		**out = **in
 	}
+	if in.PassMatchedFlowsToNextTier != nil {
+		in, out := &in.PassMatchedFlowsToNextTier, &out.PassMatchedFlowsToNextTier
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -26,6 +26,7 @@ type ClusterInformationsGetter interface {
 type ClusterInformationInterface interface {
 	Create(ctx context.Context, clusterInformation *v3.ClusterInformation, opts v1.CreateOptions) (*v3.ClusterInformation, error)
 	Update(ctx context.Context, clusterInformation *v3.ClusterInformation, opts v1.UpdateOptions) (*v3.ClusterInformation, error)
+	UpdateStatus(ctx context.Context, clusterInformation *v3.ClusterInformation, opts v1.UpdateOptions) (*v3.ClusterInformation, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v3.ClusterInformation, error)
@@ -114,6 +115,21 @@ func (c *clusterInformations) Update(ctx context.Context, clusterInformation *v3
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *clusterInformations) UpdateStatus(ctx context.Context, clusterInformation *v3.ClusterInformation, opts v1.UpdateOptions) (result *v3.ClusterInformation, err error) {
+	result = &v3.ClusterInformation{}
+	err = c.client.Put().
+		Resource("clusterinformations").
+		Name(clusterInformation.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterInformation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the clusterInformation and deletes it. Returns an error if one occurs.
 func (c *clusterInformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -82,6 +82,17 @@ func (c *FakeClusterInformations) Update(ctx context.Context, clusterInformation
 	return obj.(*v3.ClusterInformation), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeClusterInformations) UpdateStatus(ctx context.Context, clusterInformation *v3.ClusterInformation, opts v1.UpdateOptions) (*v3.ClusterInformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(clusterinformationsResource, "status", clusterInformation), &v3.ClusterInformation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterInformation), err
+}
+
 // Delete takes name of the clusterInformation and deletes it. Returns an error if one occurs.
 func (c *FakeClusterInformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
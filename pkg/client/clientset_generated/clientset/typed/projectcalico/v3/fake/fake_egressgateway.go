@@ -0,0 +1,116 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeEgressGateways implements EgressGatewayInterface
+type FakeEgressGateways struct {
+	Fake *FakeProjectcalicoV3
+	ns   string
+}
+
+var egressgatewaysResource = schema.GroupVersionResource{Group: "projectcalico.org", Version: "v3", Resource: "egressgateways"}
+
+var egressgatewaysKind = schema.GroupVersionKind{Group: "projectcalico.org", Version: "v3", Kind: "EgressGateway"}
+
+// Get takes name of the egressGateway, and returns the corresponding egressGateway object, and an error if there is any.
+func (c *FakeEgressGateways) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.EgressGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(egressgatewaysResource, c.ns, name), &v3.EgressGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.EgressGateway), err
+}
+
+// List takes label and field selectors, and returns the list of EgressGateways that match those selectors.
+func (c *FakeEgressGateways) List(ctx context.Context, opts v1.ListOptions) (result *v3.EgressGatewayList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(egressgatewaysResource, egressgatewaysKind, c.ns, opts), &v3.EgressGatewayList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.EgressGatewayList{ListMeta: obj.(*v3.EgressGatewayList).ListMeta}
+	for _, item := range obj.(*v3.EgressGatewayList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested egressGateways.
+func (c *FakeEgressGateways) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(egressgatewaysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a egressGateway and creates it.  Returns the server's representation of the egressGateway, and an error, if there is any.
+func (c *FakeEgressGateways) Create(ctx context.Context, egressGateway *v3.EgressGateway, opts v1.CreateOptions) (result *v3.EgressGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(egressgatewaysResource, c.ns, egressGateway), &v3.EgressGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.EgressGateway), err
+}
+
+// Update takes the representation of a egressGateway and updates it. Returns the server's representation of the egressGateway, and an error, if there is any.
+func (c *FakeEgressGateways) Update(ctx context.Context, egressGateway *v3.EgressGateway, opts v1.UpdateOptions) (result *v3.EgressGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(egressgatewaysResource, c.ns, egressGateway), &v3.EgressGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.EgressGateway), err
+}
+
+// Delete takes name of the egressGateway and deletes it. Returns an error if one occurs.
+func (c *FakeEgressGateways) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(egressgatewaysResource, c.ns, name), &v3.EgressGateway{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeEgressGateways) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(egressgatewaysResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.EgressGatewayList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched egressGateway.
+func (c *FakeEgressGateways) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.EgressGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(egressgatewaysResource, c.ns, name, pt, data, subresources...), &v3.EgressGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.EgressGateway), err
+}
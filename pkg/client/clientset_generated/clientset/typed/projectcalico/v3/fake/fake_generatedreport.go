@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGeneratedReports implements GeneratedReportInterface
+type FakeGeneratedReports struct {
+	Fake *FakeProjectcalicoV3
+}
+
+var generatedreportsResource = schema.GroupVersionResource{Group: "projectcalico.org", Version: "v3", Resource: "generatedreports"}
+
+var generatedreportsKind = schema.GroupVersionKind{Group: "projectcalico.org", Version: "v3", Kind: "GeneratedReport"}
+
+// Get takes name of the generatedReport, and returns the corresponding generatedReport object, and an error if there is any.
+func (c *FakeGeneratedReports) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.GeneratedReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(generatedreportsResource, name), &v3.GeneratedReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.GeneratedReport), err
+}
+
+// List takes label and field selectors, and returns the list of GeneratedReports that match those selectors.
+func (c *FakeGeneratedReports) List(ctx context.Context, opts v1.ListOptions) (result *v3.GeneratedReportList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(generatedreportsResource, generatedreportsKind, opts), &v3.GeneratedReportList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.GeneratedReportList{ListMeta: obj.(*v3.GeneratedReportList).ListMeta}
+	for _, item := range obj.(*v3.GeneratedReportList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested generatedReports.
+func (c *FakeGeneratedReports) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(generatedreportsResource, opts))
+}
+
+// Create takes the representation of a generatedReport and creates it.  Returns the server's representation of the generatedReport, and an error, if there is any.
+func (c *FakeGeneratedReports) Create(ctx context.Context, generatedReport *v3.GeneratedReport, opts v1.CreateOptions) (result *v3.GeneratedReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(generatedreportsResource, generatedReport), &v3.GeneratedReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.GeneratedReport), err
+}
+
+// Update takes the representation of a generatedReport and updates it. Returns the server's representation of the generatedReport, and an error, if there is any.
+func (c *FakeGeneratedReports) Update(ctx context.Context, generatedReport *v3.GeneratedReport, opts v1.UpdateOptions) (result *v3.GeneratedReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(generatedreportsResource, generatedReport), &v3.GeneratedReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.GeneratedReport), err
+}
+
+// Delete takes name of the generatedReport and deletes it. Returns an error if one occurs.
+func (c *FakeGeneratedReports) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(generatedreportsResource, name), &v3.GeneratedReport{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGeneratedReports) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(generatedreportsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.GeneratedReportList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched generatedReport.
+func (c *FakeGeneratedReports) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.GeneratedReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(generatedreportsResource, name, pt, data, subresources...), &v3.GeneratedReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.GeneratedReport), err
+}
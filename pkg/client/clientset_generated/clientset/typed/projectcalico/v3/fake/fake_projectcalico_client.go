@@ -14,10 +14,18 @@ type FakeProjectcalicoV3 struct {
 	*testing.Fake
 }
 
+func (c *FakeProjectcalicoV3) AlertExceptions() v3.AlertExceptionInterface {
+	return &FakeAlertExceptions{c}
+}
+
 func (c *FakeProjectcalicoV3) BGPConfigurations() v3.BGPConfigurationInterface {
 	return &FakeBGPConfigurations{c}
 }
 
+func (c *FakeProjectcalicoV3) BGPFilters() v3.BGPFilterInterface {
+	return &FakeBGPFilters{c}
+}
+
 func (c *FakeProjectcalicoV3) BGPPeers() v3.BGPPeerInterface {
 	return &FakeBGPPeers{c}
 }
@@ -30,10 +38,18 @@ func (c *FakeProjectcalicoV3) DeepPacketInspections(namespace string) v3.DeepPac
 	return &FakeDeepPacketInspections{c, namespace}
 }
 
+func (c *FakeProjectcalicoV3) EgressGateways(namespace string) v3.EgressGatewayInterface {
+	return &FakeEgressGateways{c, namespace}
+}
+
 func (c *FakeProjectcalicoV3) FelixConfigurations() v3.FelixConfigurationInterface {
 	return &FakeFelixConfigurations{c}
 }
 
+func (c *FakeProjectcalicoV3) GeneratedReports() v3.GeneratedReportInterface {
+	return &FakeGeneratedReports{c}
+}
+
 func (c *FakeProjectcalicoV3) GlobalAlerts() v3.GlobalAlertInterface {
 	return &FakeGlobalAlerts{c}
 }
@@ -70,6 +86,10 @@ func (c *FakeProjectcalicoV3) IPPools() v3.IPPoolInterface {
 	return &FakeIPPools{c}
 }
 
+func (c *FakeProjectcalicoV3) IPReservations(namespace string) v3.IPReservationInterface {
+	return &FakeIPReservations{c, namespace}
+}
+
 func (c *FakeProjectcalicoV3) KubeControllersConfigurations() v3.KubeControllersConfigurationInterface {
 	return &FakeKubeControllersConfigurations{c}
 }
@@ -94,6 +114,10 @@ func (c *FakeProjectcalicoV3) PacketCaptures() v3.PacketCaptureInterface {
 	return &FakePacketCaptures{c}
 }
 
+func (c *FakeProjectcalicoV3) PolicyRecommendations() v3.PolicyRecommendationInterface {
+	return &FakePolicyRecommendations{c}
+}
+
 func (c *FakeProjectcalicoV3) Profiles() v3.ProfileInterface {
 	return &FakeProfiles{c}
 }
@@ -82,6 +82,17 @@ func (c *FakeStagedGlobalNetworkPolicies) Update(ctx context.Context, stagedGlob
 	return obj.(*v3.StagedGlobalNetworkPolicy), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeStagedGlobalNetworkPolicies) UpdateStatus(ctx context.Context, stagedGlobalNetworkPolicy *v3.StagedGlobalNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedGlobalNetworkPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(stagedglobalnetworkpoliciesResource, "status", stagedGlobalNetworkPolicy), &v3.StagedGlobalNetworkPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.StagedGlobalNetworkPolicy), err
+}
+
 // Delete takes name of the stagedGlobalNetworkPolicy and deletes it. Returns an error if one occurs.
 func (c *FakeStagedGlobalNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
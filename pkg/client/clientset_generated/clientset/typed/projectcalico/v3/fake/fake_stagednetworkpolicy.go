@@ -88,6 +88,18 @@ func (c *FakeStagedNetworkPolicies) Update(ctx context.Context, stagedNetworkPol
 	return obj.(*v3.StagedNetworkPolicy), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeStagedNetworkPolicies) UpdateStatus(ctx context.Context, stagedNetworkPolicy *v3.StagedNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedNetworkPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(stagednetworkpoliciesResource, "status", c.ns, stagedNetworkPolicy), &v3.StagedNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.StagedNetworkPolicy), err
+}
+
 // Delete takes name of the stagedNetworkPolicy and deletes it. Returns an error if one occurs.
 func (c *FakeStagedNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -4,16 +4,24 @@
 
 package v3
 
+type AlertExceptionExpansion interface{}
+
 type BGPConfigurationExpansion interface{}
 
+type BGPFilterExpansion interface{}
+
 type BGPPeerExpansion interface{}
 
 type ClusterInformationExpansion interface{}
 
 type DeepPacketInspectionExpansion interface{}
 
+type EgressGatewayExpansion interface{}
+
 type FelixConfigurationExpansion interface{}
 
+type GeneratedReportExpansion interface{}
+
 type GlobalAlertExpansion interface{}
 
 type GlobalAlertTemplateExpansion interface{}
@@ -32,6 +40,8 @@ type HostEndpointExpansion interface{}
 
 type IPPoolExpansion interface{}
 
+type IPReservationExpansion interface{}
+
 type KubeControllersConfigurationExpansion interface{}
 
 type LicenseKeyExpansion interface{}
@@ -44,6 +54,8 @@ type NetworkSetExpansion interface{}
 
 type PacketCaptureExpansion interface{}
 
+type PolicyRecommendationExpansion interface{}
+
 type ProfileExpansion interface{}
 
 type RemoteClusterConfigurationExpansion interface{}
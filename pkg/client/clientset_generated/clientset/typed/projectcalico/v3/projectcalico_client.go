@@ -12,11 +12,15 @@ import (
 
 type ProjectcalicoV3Interface interface {
 	RESTClient() rest.Interface
+	AlertExceptionsGetter
 	BGPConfigurationsGetter
+	BGPFiltersGetter
 	BGPPeersGetter
 	ClusterInformationsGetter
 	DeepPacketInspectionsGetter
+	EgressGatewaysGetter
 	FelixConfigurationsGetter
+	GeneratedReportsGetter
 	GlobalAlertsGetter
 	GlobalAlertTemplatesGetter
 	GlobalNetworkPoliciesGetter
@@ -26,12 +30,14 @@ type ProjectcalicoV3Interface interface {
 	GlobalThreatFeedsGetter
 	HostEndpointsGetter
 	IPPoolsGetter
+	IPReservationsGetter
 	KubeControllersConfigurationsGetter
 	LicenseKeysGetter
 	ManagedClustersGetter
 	NetworkPoliciesGetter
 	NetworkSetsGetter
 	PacketCapturesGetter
+	PolicyRecommendationsGetter
 	ProfilesGetter
 	RemoteClusterConfigurationsGetter
 	StagedGlobalNetworkPoliciesGetter
@@ -45,10 +51,18 @@ type ProjectcalicoV3Client struct {
 	restClient rest.Interface
 }
 
+func (c *ProjectcalicoV3Client) AlertExceptions() AlertExceptionInterface {
+	return newAlertExceptions(c)
+}
+
 func (c *ProjectcalicoV3Client) BGPConfigurations() BGPConfigurationInterface {
 	return newBGPConfigurations(c)
 }
 
+func (c *ProjectcalicoV3Client) BGPFilters() BGPFilterInterface {
+	return newBGPFilters(c)
+}
+
 func (c *ProjectcalicoV3Client) BGPPeers() BGPPeerInterface {
 	return newBGPPeers(c)
 }
@@ -61,10 +75,18 @@ func (c *ProjectcalicoV3Client) DeepPacketInspections(namespace string) DeepPack
 	return newDeepPacketInspections(c, namespace)
 }
 
+func (c *ProjectcalicoV3Client) EgressGateways(namespace string) EgressGatewayInterface {
+	return newEgressGateways(c, namespace)
+}
+
 func (c *ProjectcalicoV3Client) FelixConfigurations() FelixConfigurationInterface {
 	return newFelixConfigurations(c)
 }
 
+func (c *ProjectcalicoV3Client) GeneratedReports() GeneratedReportInterface {
+	return newGeneratedReports(c)
+}
+
 func (c *ProjectcalicoV3Client) GlobalAlerts() GlobalAlertInterface {
 	return newGlobalAlerts(c)
 }
@@ -101,6 +123,10 @@ func (c *ProjectcalicoV3Client) IPPools() IPPoolInterface {
 	return newIPPools(c)
 }
 
+func (c *ProjectcalicoV3Client) IPReservations(namespace string) IPReservationInterface {
+	return newIPReservations(c, namespace)
+}
+
 func (c *ProjectcalicoV3Client) KubeControllersConfigurations() KubeControllersConfigurationInterface {
 	return newKubeControllersConfigurations(c)
 }
@@ -125,6 +151,10 @@ func (c *ProjectcalicoV3Client) PacketCaptures() PacketCaptureInterface {
 	return newPacketCaptures(c)
 }
 
+func (c *ProjectcalicoV3Client) PolicyRecommendations() PolicyRecommendationInterface {
+	return newPolicyRecommendations(c)
+}
+
 func (c *ProjectcalicoV3Client) Profiles() ProfileInterface {
 	return newProfiles(c)
 }
@@ -26,6 +26,7 @@ type StagedGlobalNetworkPoliciesGetter interface {
 type StagedGlobalNetworkPolicyInterface interface {
 	Create(ctx context.Context, stagedGlobalNetworkPolicy *v3.StagedGlobalNetworkPolicy, opts v1.CreateOptions) (*v3.StagedGlobalNetworkPolicy, error)
 	Update(ctx context.Context, stagedGlobalNetworkPolicy *v3.StagedGlobalNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedGlobalNetworkPolicy, error)
+	UpdateStatus(ctx context.Context, stagedGlobalNetworkPolicy *v3.StagedGlobalNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedGlobalNetworkPolicy, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v3.StagedGlobalNetworkPolicy, error)
@@ -114,6 +115,21 @@ func (c *stagedGlobalNetworkPolicies) Update(ctx context.Context, stagedGlobalNe
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *stagedGlobalNetworkPolicies) UpdateStatus(ctx context.Context, stagedGlobalNetworkPolicy *v3.StagedGlobalNetworkPolicy, opts v1.UpdateOptions) (result *v3.StagedGlobalNetworkPolicy, err error) {
+	result = &v3.StagedGlobalNetworkPolicy{}
+	err = c.client.Put().
+		Resource("stagedglobalnetworkpolicies").
+		Name(stagedGlobalNetworkPolicy.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(stagedGlobalNetworkPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the stagedGlobalNetworkPolicy and deletes it. Returns an error if one occurs.
 func (c *stagedGlobalNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
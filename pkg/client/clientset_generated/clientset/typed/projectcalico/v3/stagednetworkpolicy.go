@@ -26,6 +26,7 @@ type StagedNetworkPoliciesGetter interface {
 type StagedNetworkPolicyInterface interface {
 	Create(ctx context.Context, stagedNetworkPolicy *v3.StagedNetworkPolicy, opts v1.CreateOptions) (*v3.StagedNetworkPolicy, error)
 	Update(ctx context.Context, stagedNetworkPolicy *v3.StagedNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedNetworkPolicy, error)
+	UpdateStatus(ctx context.Context, stagedNetworkPolicy *v3.StagedNetworkPolicy, opts v1.UpdateOptions) (*v3.StagedNetworkPolicy, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v3.StagedNetworkPolicy, error)
@@ -121,6 +122,22 @@ func (c *stagedNetworkPolicies) Update(ctx context.Context, stagedNetworkPolicy
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *stagedNetworkPolicies) UpdateStatus(ctx context.Context, stagedNetworkPolicy *v3.StagedNetworkPolicy, opts v1.UpdateOptions) (result *v3.StagedNetworkPolicy, err error) {
+	result = &v3.StagedNetworkPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("stagednetworkpolicies").
+		Name(stagedNetworkPolicy.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(stagedNetworkPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the stagedNetworkPolicy and deletes it. Returns an error if one occurs.
 func (c *stagedNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -39,16 +39,24 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=projectcalico.org, Version=v3
+	case v3.SchemeGroupVersion.WithResource("alertexceptions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().AlertExceptions().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("bgpconfigurations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().BGPConfigurations().Informer()}, nil
+	case v3.SchemeGroupVersion.WithResource("bgpfilters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().BGPFilters().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("bgppeers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().BGPPeers().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("clusterinformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().ClusterInformations().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("deeppacketinspections"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().DeepPacketInspections().Informer()}, nil
+	case v3.SchemeGroupVersion.WithResource("egressgateways"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().EgressGateways().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("felixconfigurations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().FelixConfigurations().Informer()}, nil
+	case v3.SchemeGroupVersion.WithResource("generatedreports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().GeneratedReports().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("globalalerts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().GlobalAlerts().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("globalalerttemplates"):
@@ -67,6 +75,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().HostEndpoints().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("ippools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().IPPools().Informer()}, nil
+	case v3.SchemeGroupVersion.WithResource("ipreservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().IPReservations().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("kubecontrollersconfigurations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().KubeControllersConfigurations().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("licensekeys"):
@@ -79,6 +89,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().NetworkSets().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("packetcaptures"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().PacketCaptures().Informer()}, nil
+	case v3.SchemeGroupVersion.WithResource("policyrecommendations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().PolicyRecommendations().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("profiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Projectcalico().V3().Profiles().Informer()}, nil
 	case v3.SchemeGroupVersion.WithResource("remoteclusterconfigurations"):
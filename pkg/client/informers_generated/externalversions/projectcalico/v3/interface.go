@@ -10,16 +10,24 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AlertExceptions returns a AlertExceptionInformer.
+	AlertExceptions() AlertExceptionInformer
 	// BGPConfigurations returns a BGPConfigurationInformer.
 	BGPConfigurations() BGPConfigurationInformer
+	// BGPFilters returns a BGPFilterInformer.
+	BGPFilters() BGPFilterInformer
 	// BGPPeers returns a BGPPeerInformer.
 	BGPPeers() BGPPeerInformer
 	// ClusterInformations returns a ClusterInformationInformer.
 	ClusterInformations() ClusterInformationInformer
 	// DeepPacketInspections returns a DeepPacketInspectionInformer.
 	DeepPacketInspections() DeepPacketInspectionInformer
+	// EgressGateways returns a EgressGatewayInformer.
+	EgressGateways() EgressGatewayInformer
 	// FelixConfigurations returns a FelixConfigurationInformer.
 	FelixConfigurations() FelixConfigurationInformer
+	// GeneratedReports returns a GeneratedReportInformer.
+	GeneratedReports() GeneratedReportInformer
 	// GlobalAlerts returns a GlobalAlertInformer.
 	GlobalAlerts() GlobalAlertInformer
 	// GlobalAlertTemplates returns a GlobalAlertTemplateInformer.
@@ -38,6 +46,8 @@ type Interface interface {
 	HostEndpoints() HostEndpointInformer
 	// IPPools returns a IPPoolInformer.
 	IPPools() IPPoolInformer
+	// IPReservations returns a IPReservationInformer.
+	IPReservations() IPReservationInformer
 	// KubeControllersConfigurations returns a KubeControllersConfigurationInformer.
 	KubeControllersConfigurations() KubeControllersConfigurationInformer
 	// LicenseKeys returns a LicenseKeyInformer.
@@ -50,6 +60,8 @@ type Interface interface {
 	NetworkSets() NetworkSetInformer
 	// PacketCaptures returns a PacketCaptureInformer.
 	PacketCaptures() PacketCaptureInformer
+	// PolicyRecommendations returns a PolicyRecommendationInformer.
+	PolicyRecommendations() PolicyRecommendationInformer
 	// Profiles returns a ProfileInformer.
 	Profiles() ProfileInformer
 	// RemoteClusterConfigurations returns a RemoteClusterConfigurationInformer.
@@ -75,11 +87,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AlertExceptions returns a AlertExceptionInformer.
+func (v *version) AlertExceptions() AlertExceptionInformer {
+	return &alertExceptionInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // BGPConfigurations returns a BGPConfigurationInformer.
 func (v *version) BGPConfigurations() BGPConfigurationInformer {
 	return &bGPConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// BGPFilters returns a BGPFilterInformer.
+func (v *version) BGPFilters() BGPFilterInformer {
+	return &bGPFilterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // BGPPeers returns a BGPPeerInformer.
 func (v *version) BGPPeers() BGPPeerInformer {
 	return &bGPPeerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -95,11 +117,21 @@ func (v *version) DeepPacketInspections() DeepPacketInspectionInformer {
 	return &deepPacketInspectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// EgressGateways returns a EgressGatewayInformer.
+func (v *version) EgressGateways() EgressGatewayInformer {
+	return &egressGatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // FelixConfigurations returns a FelixConfigurationInformer.
 func (v *version) FelixConfigurations() FelixConfigurationInformer {
 	return &felixConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// GeneratedReports returns a GeneratedReportInformer.
+func (v *version) GeneratedReports() GeneratedReportInformer {
+	return &generatedReportInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // GlobalAlerts returns a GlobalAlertInformer.
 func (v *version) GlobalAlerts() GlobalAlertInformer {
 	return &globalAlertInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -145,6 +177,11 @@ func (v *version) IPPools() IPPoolInformer {
 	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IPReservations returns a IPReservationInformer.
+func (v *version) IPReservations() IPReservationInformer {
+	return &iPReservationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // KubeControllersConfigurations returns a KubeControllersConfigurationInformer.
 func (v *version) KubeControllersConfigurations() KubeControllersConfigurationInformer {
 	return &kubeControllersConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -175,6 +212,11 @@ func (v *version) PacketCaptures() PacketCaptureInformer {
 	return &packetCaptureInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// PolicyRecommendations returns a PolicyRecommendationInformer.
+func (v *version) PolicyRecommendations() PolicyRecommendationInformer {
+	return &policyRecommendationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Profiles returns a ProfileInformer.
 func (v *version) Profiles() ProfileInformer {
 	return &profileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
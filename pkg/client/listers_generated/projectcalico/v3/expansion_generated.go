@@ -4,10 +4,18 @@
 
 package v3
 
+// AlertExceptionListerExpansion allows custom methods to be added to
+// AlertExceptionLister.
+type AlertExceptionListerExpansion interface{}
+
 // BGPConfigurationListerExpansion allows custom methods to be added to
 // BGPConfigurationLister.
 type BGPConfigurationListerExpansion interface{}
 
+// BGPFilterListerExpansion allows custom methods to be added to
+// BGPFilterLister.
+type BGPFilterListerExpansion interface{}
+
 // BGPPeerListerExpansion allows custom methods to be added to
 // BGPPeerLister.
 type BGPPeerListerExpansion interface{}
@@ -24,10 +32,22 @@ type DeepPacketInspectionListerExpansion interface{}
 // DeepPacketInspectionNamespaceLister.
 type DeepPacketInspectionNamespaceListerExpansion interface{}
 
+// EgressGatewayListerExpansion allows custom methods to be added to
+// EgressGatewayLister.
+type EgressGatewayListerExpansion interface{}
+
+// EgressGatewayNamespaceListerExpansion allows custom methods to be added to
+// EgressGatewayNamespaceLister.
+type EgressGatewayNamespaceListerExpansion interface{}
+
 // FelixConfigurationListerExpansion allows custom methods to be added to
 // FelixConfigurationLister.
 type FelixConfigurationListerExpansion interface{}
 
+// GeneratedReportListerExpansion allows custom methods to be added to
+// GeneratedReportLister.
+type GeneratedReportListerExpansion interface{}
+
 // GlobalAlertListerExpansion allows custom methods to be added to
 // GlobalAlertLister.
 type GlobalAlertListerExpansion interface{}
@@ -64,6 +84,14 @@ type HostEndpointListerExpansion interface{}
 // IPPoolLister.
 type IPPoolListerExpansion interface{}
 
+// IPReservationListerExpansion allows custom methods to be added to
+// IPReservationLister.
+type IPReservationListerExpansion interface{}
+
+// IPReservationNamespaceListerExpansion allows custom methods to be added to
+// IPReservationNamespaceLister.
+type IPReservationNamespaceListerExpansion interface{}
+
 // KubeControllersConfigurationListerExpansion allows custom methods to be added to
 // KubeControllersConfigurationLister.
 type KubeControllersConfigurationListerExpansion interface{}
@@ -96,6 +124,10 @@ type NetworkSetNamespaceListerExpansion interface{}
 // PacketCaptureLister.
 type PacketCaptureListerExpansion interface{}
 
+// PolicyRecommendationListerExpansion allows custom methods to be added to
+// PolicyRecommendationLister.
+type PolicyRecommendationListerExpansion interface{}
+
 // ProfileListerExpansion allows custom methods to be added to
 // ProfileLister.
 type ProfileListerExpansion interface{}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numorstring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// maxPortNameLen is the maximum length of a named port, following the IANA service name convention.
+const maxPortNameLen = 15
+
+var (
+	portNameRegex   = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	portNameLetters = regexp.MustCompile(`[a-z]`)
+)
+
+// NamedPortOrNumber is a type that can hold either a single numeric port or a named port.  When
+// used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type.
+// Unlike Port, it does not represent port ranges.
+//
+//     - A numeric port must be in the range 1-65535.
+//     - A named port must be a valid DNS label of no more than 15 characters that contains at
+//       least one letter, for example "http" or "metrics-1".
+type NamedPortOrNumber struct {
+	Type   NumOrStringType `json:"type"`
+	NumVal uint16          `json:"numVal"`
+	StrVal string          `json:"strVal"`
+}
+
+// NamedPortOrNumberFromInt creates a NamedPortOrNumber struct from a numeric port.
+func NamedPortOrNumberFromInt(p uint16) (NamedPortOrNumber, error) {
+	if p == 0 {
+		return NamedPortOrNumber{}, errors.New("port number must be between 1 and 65535")
+	}
+	return NamedPortOrNumber{Type: NumOrStringNum, NumVal: p}, nil
+}
+
+// NamedPortOrNumberFromString creates a NamedPortOrNumber struct from its string representation.  A
+// string of digits is parsed as a numeric port, anything else must be a valid port name.
+func NamedPortOrNumberFromString(s string) (NamedPortOrNumber, error) {
+	if allDigits.MatchString(s) {
+		num, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			msg := fmt.Sprintf("invalid port number (%s)", s)
+			return NamedPortOrNumber{}, errors.New(msg)
+		}
+		return NamedPortOrNumberFromInt(uint16(num))
+	}
+
+	if len(s) > maxPortNameLen || !portNameRegex.MatchString(s) || !portNameLetters.MatchString(s) {
+		msg := fmt.Sprintf("invalid name for named port (%s)", s)
+		return NamedPortOrNumber{}, errors.New(msg)
+	}
+	return NamedPortOrNumber{Type: NumOrStringString, StrVal: s}, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+func (p *NamedPortOrNumber) UnmarshalJSON(b []byte) error {
+	var v NamedPortOrNumber
+	if b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+
+		var err error
+		if v, err = NamedPortOrNumberFromString(s); err != nil {
+			return err
+		}
+	} else {
+		// It's not a string, it must be a port number.
+		var i uint16
+		if err := json.Unmarshal(b, &i); err != nil {
+			return err
+		}
+
+		var err error
+		if v, err = NamedPortOrNumberFromInt(i); err != nil {
+			return err
+		}
+	}
+	*p = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface.
+func (p NamedPortOrNumber) MarshalJSON() ([]byte, error) {
+	if p.Type == NumOrStringString {
+		return json.Marshal(p.StrVal)
+	}
+	return json.Marshal(p.NumVal)
+}
+
+// String returns the port name, or the string representation of the port number.
+func (p NamedPortOrNumber) String() string {
+	if p.Type == NumOrStringString {
+		return p.StrVal
+	}
+	return strconv.FormatUint(uint64(p.NumVal), 10)
+}
+
+// DeepCopyInto copies the receiver into out.  The type only holds values so a shallow copy is
+// sufficient.
+func (p *NamedPortOrNumber) DeepCopyInto(out *NamedPortOrNumber) {
+	*out = *p
+}
+
+// DeepCopy creates a new NamedPortOrNumber by copying the receiver.
+func (p *NamedPortOrNumber) DeepCopy() *NamedPortOrNumber {
+	if p == nil {
+		return nil
+	}
+	out := new(NamedPortOrNumber)
+	p.DeepCopyInto(out)
+	return out
+}
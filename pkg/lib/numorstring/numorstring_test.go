@@ -30,6 +30,7 @@ func init() {
 	asNumberType := reflect.TypeOf(numorstring.ASNumber(0))
 	protocolType := reflect.TypeOf(numorstring.Protocol{})
 	portType := reflect.TypeOf(numorstring.Port{})
+	namedPortType := reflect.TypeOf(numorstring.NamedPortOrNumber{})
 
 	// Perform tests of JSON unmarshaling of the various field types.
 	DescribeTable("NumOrStringJSONUnmarshaling",
@@ -82,6 +83,21 @@ func init() {
 		Entry("should reject bad named port string", "\"*\"", portType, nil),
 		Entry("should reject bad port string", "\"1:2", portType, nil),
 
+		// NamedPortOrNumber tests.
+		Entry("should accept 1 named port or number as int", "1", namedPortType, namedPortFromString("1")),
+		Entry("should accept 65535 named port or number as int", "65535", namedPortType, namedPortFromString("65535")),
+		Entry("should accept 8080 named port or number as string", "\"8080\"", namedPortType, namedPortFromString("8080")),
+		Entry("should accept http named port or number", "\"http\"", namedPortType, namedPortFromString("http")),
+		Entry("should accept 15 character named port", "\"abcdefghij-1234\"", namedPortType, namedPortFromString("abcdefghij-1234")),
+		Entry("should reject 0 named port or number as int", "0", namedPortType, nil),
+		Entry("should reject 65536 named port or number as int", "65536", namedPortType, nil),
+		Entry("should reject 0 named port or number as string", "\"0\"", namedPortType, nil),
+		Entry("should reject 16 character named port", "\"abcdefghij-12345\"", namedPortType, nil),
+		Entry("should reject upper case named port", "\"HTTP\"", namedPortType, nil),
+		Entry("should reject named port with leading hyphen", "\"-http\"", namedPortType, nil),
+		Entry("should reject named port with trailing hyphen", "\"http-\"", namedPortType, nil),
+		Entry("should reject port range for named port or number", "\"1:10\"", namedPortType, nil),
+
 		// Protocol tests.  Invalid integer values will be stored as strings.
 		Entry("should accept 0 protocol as int", "0", protocolType, numorstring.ProtocolFromInt(0)),
 		Entry("should accept 255 protocol as int", "255", protocolType, numorstring.ProtocolFromInt(255)),
@@ -119,6 +135,10 @@ func init() {
 		Entry("should marshal port range of 20:30", portFromRange(20, 30), "\"20:30\""),
 		Entry("should marshal named port", numorstring.NamedPort("foobar"), `"foobar"`),
 
+		// NamedPortOrNumber tests.
+		Entry("should marshal named port or number of 80", namedPortFromString("80"), "80"),
+		Entry("should marshal named port or number of http", namedPortFromString("http"), `"http"`),
+
 		// Protocol tests.
 		Entry("should marshal protocol of 0", numorstring.ProtocolFromInt(0), "0"),
 		Entry("should marshal protocol of udp", numorstring.ProtocolFromString("UDP"), "\"UDP\""),
@@ -139,6 +159,10 @@ func init() {
 		Entry("should stringify port of 20", numorstring.SinglePort(20), "20"),
 		Entry("should stringify port range of 10:20", portFromRange(10, 20), "10:20"),
 
+		// NamedPortOrNumber tests.
+		Entry("should stringify named port or number of 443", namedPortFromString("443"), "443"),
+		Entry("should stringify named port or number of https", namedPortFromString("https"), "https"),
+
 		// Protocol tests.
 		Entry("should stringify protocol of 0", numorstring.ProtocolFromInt(0), "0"),
 		Entry("should stringify protocol of udp", numorstring.ProtocolFromString("UDP"), "UDP"),
@@ -203,3 +227,8 @@ func portFromString(s string) numorstring.Port {
 	p, _ := numorstring.PortFromString(s)
 	return p
 }
+
+func namedPortFromString(s string) numorstring.NamedPortOrNumber {
+	p, _ := numorstring.NamedPortOrNumberFromString(s)
+	return p
+}
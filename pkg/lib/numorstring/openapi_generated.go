@@ -15,9 +15,45 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/tigera/api/pkg/lib/numorstring.Port":          schema_api_pkg_lib_numorstring_Port(ref),
-		"github.com/tigera/api/pkg/lib/numorstring.Protocol":      schema_api_pkg_lib_numorstring_Protocol(ref),
-		"github.com/tigera/api/pkg/lib/numorstring.Uint8OrString": schema_api_pkg_lib_numorstring_Uint8OrString(ref),
+		"github.com/tigera/api/pkg/lib/numorstring.NamedPortOrNumber": schema_api_pkg_lib_numorstring_NamedPortOrNumber(ref),
+		"github.com/tigera/api/pkg/lib/numorstring.Port":              schema_api_pkg_lib_numorstring_Port(ref),
+		"github.com/tigera/api/pkg/lib/numorstring.Protocol":          schema_api_pkg_lib_numorstring_Protocol(ref),
+		"github.com/tigera/api/pkg/lib/numorstring.Uint8OrString":     schema_api_pkg_lib_numorstring_Uint8OrString(ref),
+	}
+}
+
+func schema_api_pkg_lib_numorstring_NamedPortOrNumber(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedPortOrNumber is a type that can hold either a single numeric port or a named port.  When used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type. Unlike Port, it does not represent port ranges.\n\n    - A numeric port must be in the range 1-65535.\n    - A named port must be a valid DNS label of no more than 15 characters that contains at\n      least one letter, for example \"http\" or \"metrics-1\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"numVal": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"strVal": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"type", "numVal", "strVal"},
+			},
+		},
 	}
 }
 
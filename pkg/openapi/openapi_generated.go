@@ -17,13 +17,21 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.AlertException":                     schema_pkg_apis_projectcalico_v3_AlertException(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.AlertExceptionList":                 schema_pkg_apis_projectcalico_v3_AlertExceptionList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.AlertExceptionSpec":                 schema_pkg_apis_projectcalico_v3_AlertExceptionSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditEventsSelection":               schema_pkg_apis_projectcalico_v3_AuditEventsSelection(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditResource":                      schema_pkg_apis_projectcalico_v3_AuditResource(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditSummary":                       schema_pkg_apis_projectcalico_v3_AuditSummary(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.AutoHostEndpointConfig":             schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPCommunityAdvertisement":          schema_pkg_apis_projectcalico_v3_BGPCommunityAdvertisement(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPConfiguration":                   schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPConfigurationList":               schema_pkg_apis_projectcalico_v3_BGPConfigurationList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPConfigurationSpec":               schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilter":                          schema_pkg_apis_projectcalico_v3_BGPFilter(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterList":                      schema_pkg_apis_projectcalico_v3_BGPFilterList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterRule":                      schema_pkg_apis_projectcalico_v3_BGPFilterRule(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterSpec":                      schema_pkg_apis_projectcalico_v3_BGPFilterSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPassword":                        schema_pkg_apis_projectcalico_v3_BGPPassword(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeer":                            schema_pkg_apis_projectcalico_v3_BGPPeer(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeerList":                        schema_pkg_apis_projectcalico_v3_BGPPeerList(ref),
@@ -40,6 +48,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformation":                 schema_pkg_apis_projectcalico_v3_ClusterInformation(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationList":             schema_pkg_apis_projectcalico_v3_ClusterInformationList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationSpec":             schema_pkg_apis_projectcalico_v3_ClusterInformationSpec(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationStatus":           schema_pkg_apis_projectcalico_v3_ClusterInformationStatus(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.Community":                          schema_pkg_apis_projectcalico_v3_Community(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.CompletedReportJob":                 schema_pkg_apis_projectcalico_v3_CompletedReportJob(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ControllersConfig":                  schema_pkg_apis_projectcalico_v3_ControllersConfig(ref),
@@ -50,6 +59,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.DeepPacketInspectionList":           schema_pkg_apis_projectcalico_v3_DeepPacketInspectionList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.DeepPacketInspectionSpec":           schema_pkg_apis_projectcalico_v3_DeepPacketInspectionSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.DeepPacketInspectionStatus":         schema_pkg_apis_projectcalico_v3_DeepPacketInspectionStatus(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGateway":                      schema_pkg_apis_projectcalico_v3_EgressGateway(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayIPPool":                schema_pkg_apis_projectcalico_v3_EgressGatewayIPPool(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayList":                  schema_pkg_apis_projectcalico_v3_EgressGatewayList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayRef":                   schema_pkg_apis_projectcalico_v3_EgressGatewayRef(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewaySpec":                  schema_pkg_apis_projectcalico_v3_EgressGatewaySpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressSpec":                         schema_pkg_apis_projectcalico_v3_EgressSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.EndpointPort":                       schema_pkg_apis_projectcalico_v3_EndpointPort(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.EndpointsReportEndpoint":            schema_pkg_apis_projectcalico_v3_EndpointsReportEndpoint(ref),
@@ -61,11 +75,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.EntityRule":                         schema_pkg_apis_projectcalico_v3_EntityRule(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ErrorCondition":                     schema_pkg_apis_projectcalico_v3_ErrorCondition(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.EtcdConfig":                         schema_pkg_apis_projectcalico_v3_EtcdConfig(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.FeatureDetectOverrides":             schema_pkg_apis_projectcalico_v3_FeatureDetectOverrides(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.FederatedServicesControllerConfig":  schema_pkg_apis_projectcalico_v3_FederatedServicesControllerConfig(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.FelixConfiguration":                 schema_pkg_apis_projectcalico_v3_FelixConfiguration(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.FelixConfigurationList":             schema_pkg_apis_projectcalico_v3_FelixConfigurationList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.FelixConfigurationSpec":             schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.FlowEndpoint":                       schema_pkg_apis_projectcalico_v3_FlowEndpoint(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReport":                    schema_pkg_apis_projectcalico_v3_GeneratedReport(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReportList":                schema_pkg_apis_projectcalico_v3_GeneratedReportList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReportSpec":                schema_pkg_apis_projectcalico_v3_GeneratedReportSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalAlert":                        schema_pkg_apis_projectcalico_v3_GlobalAlert(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalAlertList":                    schema_pkg_apis_projectcalico_v3_GlobalAlertList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalAlertSpec":                    schema_pkg_apis_projectcalico_v3_GlobalAlertSpec(ref),
@@ -84,10 +102,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalReportType":                   schema_pkg_apis_projectcalico_v3_GlobalReportType(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalReportTypeList":               schema_pkg_apis_projectcalico_v3_GlobalReportTypeList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeed":                   schema_pkg_apis_projectcalico_v3_GlobalThreatFeed(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedAuth":               schema_pkg_apis_projectcalico_v3_GlobalThreatFeedAuth(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedList":               schema_pkg_apis_projectcalico_v3_GlobalThreatFeedList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedSpec":               schema_pkg_apis_projectcalico_v3_GlobalThreatFeedSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedStatus":             schema_pkg_apis_projectcalico_v3_GlobalThreatFeedStatus(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeader":                         schema_pkg_apis_projectcalico_v3_HTTPHeader(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch":                    schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeaderSource":                   schema_pkg_apis_projectcalico_v3_HTTPHeaderSource(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPMatch":                          schema_pkg_apis_projectcalico_v3_HTTPMatch(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPath":                           schema_pkg_apis_projectcalico_v3_HTTPPath(ref),
@@ -100,6 +120,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPPool":                             schema_pkg_apis_projectcalico_v3_IPPool(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPPoolList":                         schema_pkg_apis_projectcalico_v3_IPPoolList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPPoolSpec":                         schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservation":                      schema_pkg_apis_projectcalico_v3_IPReservation(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservationList":                  schema_pkg_apis_projectcalico_v3_IPReservationList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservationSpec":                  schema_pkg_apis_projectcalico_v3_IPReservationSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.KubeConfig":                         schema_pkg_apis_projectcalico_v3_KubeConfig(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.KubeControllersConfiguration":       schema_pkg_apis_projectcalico_v3_KubeControllersConfiguration(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationList":   schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationList(ref),
@@ -130,16 +153,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.PacketCaptureSpec":                  schema_pkg_apis_projectcalico_v3_PacketCaptureSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.PacketCaptureStatus":                schema_pkg_apis_projectcalico_v3_PacketCaptureStatus(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyControllerConfig":             schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendation":               schema_pkg_apis_projectcalico_v3_PolicyRecommendation(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationList":           schema_pkg_apis_projectcalico_v3_PolicyRecommendationList(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationSpec":           schema_pkg_apis_projectcalico_v3_PolicyRecommendationSpec(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationStatus":         schema_pkg_apis_projectcalico_v3_PolicyRecommendationStatus(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.PrefixAdvertisement":                schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.Profile":                            schema_pkg_apis_projectcalico_v3_Profile(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ProfileList":                        schema_pkg_apis_projectcalico_v3_ProfileList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ProfileSpec":                        schema_pkg_apis_projectcalico_v3_ProfileSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ProtoPort":                          schema_pkg_apis_projectcalico_v3_ProtoPort(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.Pull":                               schema_pkg_apis_projectcalico_v3_Pull(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.RecommendedPolicy":                  schema_pkg_apis_projectcalico_v3_RecommendedPolicy(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.RemoteClusterConfiguration":         schema_pkg_apis_projectcalico_v3_RemoteClusterConfiguration(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.RemoteClusterConfigurationList":     schema_pkg_apis_projectcalico_v3_RemoteClusterConfigurationList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.RemoteClusterConfigurationSpec":     schema_pkg_apis_projectcalico_v3_RemoteClusterConfigurationSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ReportData":                         schema_pkg_apis_projectcalico_v3_ReportData(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.ReportInventoryConfig":              schema_pkg_apis_projectcalico_v3_ReportInventoryConfig(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ReportJob":                          schema_pkg_apis_projectcalico_v3_ReportJob(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ReportSpec":                         schema_pkg_apis_projectcalico_v3_ReportSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ReportStatus":                       schema_pkg_apis_projectcalico_v3_ReportStatus(ref),
@@ -163,14 +192,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicy":                schema_pkg_apis_projectcalico_v3_StagedNetworkPolicy(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicyList":            schema_pkg_apis_projectcalico_v3_StagedNetworkPolicyList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec":            schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedPolicyStatus":                 schema_pkg_apis_projectcalico_v3_StagedPolicyStatus(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormat":                   schema_pkg_apis_projectcalico_v3_ThreatFeedFormat(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormatCSV":                schema_pkg_apis_projectcalico_v3_ThreatFeedFormatCSV(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormatJSON":               schema_pkg_apis_projectcalico_v3_ThreatFeedFormatJSON(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormatNewlineDelimited":   schema_pkg_apis_projectcalico_v3_ThreatFeedFormatNewlineDelimited(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedRef":                      schema_pkg_apis_projectcalico_v3_ThreatFeedRef(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.Tier":                               schema_pkg_apis_projectcalico_v3_Tier(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.TierList":                           schema_pkg_apis_projectcalico_v3_TierList(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.TierSpec":                           schema_pkg_apis_projectcalico_v3_TierSpec(ref),
 		"github.com/tigera/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig":   schema_pkg_apis_projectcalico_v3_WorkloadEndpointControllerConfig(ref),
+		"github.com/tigera/api/pkg/apis/projectcalico/v3.cronField":                          schema_pkg_apis_projectcalico_v3_cronField(ref),
+		"github.com/tigera/api/pkg/lib/numorstring.NamedPortOrNumber":                        schema_api_pkg_lib_numorstring_NamedPortOrNumber(ref),
 		"github.com/tigera/api/pkg/lib/numorstring.Port":                                     schema_api_pkg_lib_numorstring_Port(ref),
 		"github.com/tigera/api/pkg/lib/numorstring.Protocol":                                 schema_api_pkg_lib_numorstring_Protocol(ref),
 		"github.com/tigera/api/pkg/lib/numorstring.Uint8OrString":                            schema_api_pkg_lib_numorstring_Uint8OrString(ref),
@@ -461,6 +494,155 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_AlertException(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertException suppresses the security alerts that match its specification, optionally only for a limited time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the AlertException.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.AlertExceptionSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.AlertExceptionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_AlertExceptionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertExceptionList contains a list of AlertException resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.AlertException"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.AlertException", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_AlertExceptionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertExceptionSpec contains the specification for an alert exception resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The description is displayed by the UI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime defines the start time from which this alert exception will take effect. If omitted, the exception takes effect immediately.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTime defines the end time at which this alert exception will expire. If omitted, the alert exception does not expire.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a Calico label selector expression that picks out the endpoints to which the exception applies.  If omitted, the exception applies to all endpoints.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alertName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertName is the name of the alert to suppress.  If omitted, alerts of any name are suppressed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alertNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertNamespace is the namespace of the alerts to suppress.  If omitted, alerts in any namespace are suppressed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_AuditEventsSelection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -597,6 +779,48 @@ func schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BGPCommunityAdvertisement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPCommunityAdvertisement attaches BGP communities to the routes advertised for a CIDR.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR of the advertised routes to which the communities are attached.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"communities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Communities is a list of community values of format `aa:nn` or `aa:nn:mm`, or well-known community names such as `no-export`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action determines whether the communities are added to those already on the route (\"Add\") or replace them (\"Set\").  [Default: Add]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -807,40 +1031,34 @@ func schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref common.ReferenceC
 							Format:      "int32",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.Community", "github.com/tigera/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock"},
-	}
-}
-
-func schema_pkg_apis_projectcalico_v3_BGPPassword(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BGPPassword contains ways to specify a BGP password.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"communityAdvertisements": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the node pod's namespace.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "CommunityAdvertisements attaches BGP communities to the routes advertised for the specified CIDRs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPCommunityAdvertisement"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPCommunityAdvertisement", "github.com/tigera/api/pkg/apis/projectcalico/v3.Community", "github.com/tigera/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/tigera/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_BGPPeer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_BGPFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "BGPFilter contains a set of rules that control which routes are imported from and exported to the BGP peers that reference it in their Filters.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -865,22 +1083,22 @@ func schema_pkg_apis_projectcalico_v3_BGPPeer(ref common.ReferenceCallback) comm
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeerSpec"),
+							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_BGPFilterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BGPPeerList is a list of BGPPeer resources.",
+				Description: "BGPFilterList is a list of BGPFilter resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -910,7 +1128,7 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeer"),
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilter"),
 									},
 								},
 							},
@@ -921,70 +1139,266 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeer", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilter", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_BGPFilterRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BGPPeerSpec contains the specification for a BGPPeer resource.",
+				Description: "BGPFilterRule defines a BGP filter rule consisting of a CIDR match and an action to take on matching routes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"node": {
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The node name identifying the Calico node instance that is targeted by this peer. If this is not set, and no nodeSelector is specified, then this BGP peer selects all nodes in the cluster.",
+							Description: "The CIDR that routes are compared against, using MatchOperator.  If not set, the rule matches all routes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"matchOperator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector for the nodes that should have this peering.  When this is set, the Node field must be empty.",
+							Description: "How routes are compared against CIDR.  \"In\" matches routes that are within CIDR and \"NotIn\" matches routes that are not.  Must be set when CIDR is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"peerIP": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The IP address of the peer followed by an optional port number to peer with. If port number is given, format should be `[<IPv6>]:port` or `<IPv4>:<port>` for IPv4. If optional port number is not set, and this peer IP and ASNumber belongs to a calico/node with ListenPort set in BGPConfiguration, then we use that port to peer.",
+							Description: "The source of the routes that the rule matches.  If set to \"RemotePeers\", the rule only matches routes learned from BGP peers.  If not set, the rule matches routes from all sources.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"asNumber": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The AS Number of the peer.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "The action to take on routes that match the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extensions": {
+				},
+				Required: []string{"action"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPFilterSpec contains the IngressRules and EgressRules of a BGPFilter resource.  The rules are evaluated in order and the first rule that matches a route determines the action taken.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingressRules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extensions is a mapping of keys to values that can be used in custom BGP templates",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "The ordered set of rules applied to routes received from the BGP peers that use this filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterRule"),
 									},
 								},
 							},
 						},
 					},
-					"peerSelector": {
+					"egressRules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector for the remote nodes to peer with.  When this is set, the PeerIP and ASNumber fields must be empty.  For each peering between the local node and selected remote nodes, we configure an IPv4 peering if both ends have NodeBGPSpec.IPv4Address specified, and an IPv6 peering if both ends have NodeBGPSpec.IPv6Address specified.  The remote AS number comes from the remote node's NodeBGPSpec.ASNumber, or the global default if that is not set.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The ordered set of rules applied to routes advertised to the BGP peers that use this filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterRule"),
+									},
+								},
+							},
 						},
 					},
-					"keepOriginalNextHop": {
-						SchemaProps: spec.SchemaProps{
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPFilterRule"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPPassword(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPPassword contains ways to specify a BGP password.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the node pod's namespace.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPPeer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeerSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPPeerList is a list of BGPPeer resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeer"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.BGPPeer", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPPeerSpec contains the specification for a BGPPeer resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The node name identifying the Calico node instance that is targeted by this peer. If this is not set, and no nodeSelector is specified, then this BGP peer selects all nodes in the cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector for the nodes that should have this peering.  When this is set, the Node field must be empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"peerIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The IP address of the peer followed by an optional port number to peer with. If port number is given, format should be `[<IPv6>]:port` or `<IPv4>:<port>` for IPv4. If optional port number is not set, and this peer IP and ASNumber belongs to a calico/node with ListenPort set in BGPConfiguration, then we use that port to peer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The AS Number of the peer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions is a mapping of keys to values that can be used in custom BGP templates",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"peerSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector for the remote nodes to peer with.  When this is set, the PeerIP and ASNumber fields must be empty.  For each peering between the local node and selected remote nodes, we configure an IPv4 peering if both ends have NodeBGPSpec.IPv4Address specified, and an IPv6 peering if both ends have NodeBGPSpec.IPv6Address specified.  The remote AS number comes from the remote node's NodeBGPSpec.ASNumber, or the global default if that is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keepOriginalNextHop": {
+						SchemaProps: spec.SchemaProps{
 							Description: "Option to keep the original nexthop field when routes are sent to a BGP Peer. Setting \"true\" configures the selected BGP Peers node to use the \"next hop keep;\" instead of \"next hop self;\"(default) in the specific branch of the Node on \"bird.cfg\".",
 							Type:        []string{"boolean"},
 							Format:      "",
@@ -1023,6 +1437,25 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"gracefulRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The RFC 4724 graceful restart time, i.e. how long stale routes from the peer are retained after the session drops while waiting for the peer to restart.  When specified, it takes precedence over MaxRestartTime for graceful restart.  Must not be negative.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"longLivedGracefulRestartEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enables long-lived graceful restart on the peerings generated by this BGPPeer resource. Setting this to true is equivalent to setting RestartMode to \"LongLivedGracefulRestart\"; it must not be combined with RestartMode \"GracefulRestart\".",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"longLivedGracefulRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The long-lived graceful restart stale time, i.e. how long stale routes are retained once the graceful restart time has expired.  When specified, it takes precedence over MaxRestartTime for long-lived graceful restart.  Must not be negative.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"birdGatewayMode": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Specifies the BIRD \"gateway\" mode, i.e. method for computing the immediate next hop for each received route, for peerings generated by this BGPPeer resource.  Default value \"Recursive\" means \"gateway recursive\".  \"DirectIfDirectlyConnected\" means to configure \"gateway direct\" when the peer is directly connected.",
@@ -1030,6 +1463,21 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ordered set of BGPFilters applied on this BGP peer.  Each entry is the name of a BGPFilter resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -1498,11 +1946,17 @@ func schema_pkg_apis_projectcalico_v3_ClusterInformation(ref common.ReferenceCal
 							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationSpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.ClusterInformationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1604,9 +2058,52 @@ func schema_pkg_apis_projectcalico_v3_ClusterInformationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"managementClusterAddr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagementClusterAddr is the address at which a managed cluster reaches its management cluster. Only set on managed clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the version of Kubernetes that the cluster is running",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_ClusterInformationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterInformationStatus contains the observed state of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastUpdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdated is the time at which the cluster information was last refreshed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"operatorVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperatorVersion is the version of the operator that manages the cluster's Calico installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1936,18 +2433,56 @@ func schema_pkg_apis_projectcalico_v3_DeepPacketInspectionSpec(ref common.Refere
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_projectcalico_v3_DeepPacketInspectionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeepPacketInspectionStatus contains status of deep packet inspection in each node.",
-				Type:        []string{"object"},
+					"enabledApplicationLayers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The application layer protocol analyzers to enable, any of \"HTTP\", \"DNS\", \"TLS\" and \"SMB\". If omitted, all analyzers are enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"threatFeedRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The threat feeds that are consulted when inspecting packets.  If omitted, no threat feeds are consulted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedRef"),
+									},
+								},
+							},
+						},
+					},
+					"maxPacketInspectionDepth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of bytes of each packet that are inspected.  [Default: 8192]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedRef"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_DeepPacketInspectionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeepPacketInspectionStatus contains status of deep packet inspection in each node.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"nodes": {
 						SchemaProps: spec.SchemaProps{
@@ -1970,6 +2505,208 @@ func schema_pkg_apis_projectcalico_v3_DeepPacketInspectionStatus(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_EgressGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGateway configures a set of egress gateway pods, including the IP pools that their addresses are allocated from and how the set is scaled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the EgressGateway.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewaySpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewaySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_EgressGatewayIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewayIPPool identifies an IP pool used by an egress gateway, by name or by CIDR.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the IP pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is the CIDR of the IP pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_EgressGatewayList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewayList contains a list of EgressGateway resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGateway"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGateway", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_EgressGatewayRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewayRef identifies a set of egress gateways.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is an expression used to pick out the egress gateway pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector is an expression used to pick out the namespaces of the egress gateway pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_EgressGatewaySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewaySpec contains the specification for an EgressGateway resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is an expression used to pick out the egress gateway pods managed by this resource. The selector will only match pods in the same namespace as the EgressGateway resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPPools lists the IP pools that addresses for the egress gateway pods are allocated from.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayIPPool"),
+									},
+								},
+							},
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of egress gateway pods.  Must not be greater than MaxReplicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of egress gateway pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scaleDownDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelay is how long the number of egress gateway pods must have exceeded demand before it is reduced.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayIPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_EgressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2436,7 +3173,7 @@ func schema_pkg_apis_projectcalico_v3_EntityRule(ref common.ReferenceCallback) c
 					},
 					"ports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports is an optional field that restricts the rule to only apply to traffic that has a source (destination) port that matches one of these ranges/values. This value is a list of integers or strings that represent ranges of ports.\n\nSince only some protocols have ports, if any ports are specified it requires the Protocol match in the Rule to be set to \"TCP\" or \"UDP\".",
+							Description: "Ports is an optional field that restricts the rule to only apply to traffic that has a source (destination) port that matches one of these ranges/values. This value is a list of integers or strings that represent ranges of ports.\n\nAn entry may also be the name of a port, such as \"http\", rather than a number.  Named ports are resolved when the policy is enforced, using the container ports declared by each matching pod (or the named ports of each matching host endpoint), so a policy does not need to know the numeric port at authoring time.  The name must be a valid port name (an IANA_SVC_NAME) and the protocol of the named port must match the rule's Protocol.\n\nSince only some protocols have ports, if any ports are specified it requires the Protocol match in the Rule to be set to \"TCP\" or \"UDP\".",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2463,6 +3200,28 @@ func schema_pkg_apis_projectcalico_v3_EntityRule(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"dnsNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSNames is an optional field that restricts the rule to only apply to traffic that originates from (or terminates at) IP addresses that Felix's DNS cache has recorded as resolutions of one of the given names.  Each name is a fully qualified domain name or a wildcard such as \"*.example.com\".  Unlike Domains, DNSNames may be used with any action and in both source and destination entity rules.  It relies on Felix learning DNS resolutions, so DNSTrustedServers must include the cluster's DNS servers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dnsLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLabelSelector is an optional field that contains a selector expression matched against the labels of the DNS names tracked by Felix's DNS cache.  Only traffic that originates from (or terminates at) IP addresses resolved from a matching DNS name will be matched.  Like DNSNames, it relies on DNSTrustedServers being configured.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"notNets": {
 						SchemaProps: spec.SchemaProps{
 							Description: "NotNets is the negated version of the Nets field.",
@@ -2613,24 +3372,61 @@ func schema_pkg_apis_projectcalico_v3_EtcdConfig(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_FederatedServicesControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_FeatureDetectOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FederatedServicesControllerConfig configures the federated services controller, which syncs Kubernetes services from remote clusters.",
+				Description: "FeatureDetectOverrides overrides the result of Felix's feature detection.  A nil field means that the feature is auto-detected.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"reconcilerPeriod": {
+					"snatFullyRandom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReconcilerPeriod is the period to perform reconciliation. [Default: 5m]",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"masqFullyRandom": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"restoreSupportsLock": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"bpfKTimeGetNSSupported": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_FederatedServicesControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FederatedServicesControllerConfig configures the federated services controller, which syncs Kubernetes services from remote clusters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reconcilerPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilerPeriod is the period to perform reconciliation. [Default: 5m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2760,6 +3556,18 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"interfaceRefreshIntervalOnError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceRefreshIntervalOnError is the period at which Felix rescans local interfaces after it fails to read an interface's state, so that an interface that briefly disappears is picked up again quickly.  Once a rescan succeeds, Felix falls back to InterfaceRefreshInterval.  [Default: 5s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"arpRefreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArpRefreshInterval is the period at which Felix rescans the ARP table to refresh the entries for local workload endpoints.  On nodes with many endpoints, a longer interval reduces the cost of the rescans. Set to 0 to disable ARP refresh. [Default: 60s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"iptablesRefreshInterval": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IptablesRefreshInterval is the period at which Felix re-checks the IP sets in the dataplane to ensure that no other process has accidentally broken Calico's rules. Set to 0 to disable IP sets refresh. Note: the default for this value is lower than the other refresh intervals as a workaround for a Linux kernel bug that was fixed in kernel version 4.11. If you are using v4.11 or greater you may want to set this to, a higher value to reduce Felix CPU usage. [Default: 10s]",
@@ -2793,11 +3601,17 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 					},
 					"featureDetectOverride": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureDetectOverride is used to override the feature detection. Values are specified in a comma separated list with no spaces, example; \"SNATFullyRandom=true,MASQFullyRandom=false,RestoreSupportsLock=\". \"true\" or \"false\" will force the feature, empty or omitted values are auto-detected.",
+							Description: "FeatureDetectOverride is used to override the feature detection. Values are specified in a comma separated list with no spaces, example; \"SNATFullyRandom=true,MASQFullyRandom=false,RestoreSupportsLock=\". \"true\" or \"false\" will force the feature, empty or omitted values are auto-detected.\n\nDeprecated: Use FeatureDetectOverrides instead.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"featureDetectOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureDetectOverrides is used to override the feature detection.  Each field that is set forces the corresponding feature on or off; unset fields are auto-detected.  Takes precedence over FeatureDetectOverride.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.FeatureDetectOverrides"),
+						},
+					},
 					"ipsetsRefreshInterval": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IpsetsRefreshInterval is the period at which Felix re-checks all iptables state to ensure that no other process has accidentally broken Calico's rules. Set to 0 to disable iptables refresh. [Default: 90s]",
@@ -2806,8 +3620,23 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 					},
 					"maxIpsetSize": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "MaxIpsetSize is the maximum number of entries that Felix allows in a single IP set.\n\nDeprecated: use MaxIpsetSoftLimit and MaxIpsetHardLimit instead.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxIpsetSoftLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIpsetSoftLimit is the number of entries in an IP set at which Felix starts to aggregate entries, applying increasing levels of aggregation as the IP set approaches the limit. Must not be greater than MaxIpsetHardLimit. [Default: unset]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxIpsetHardLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIpsetHardLimit is the maximum number of entries in an IP set.  Once it is reached, Felix refuses to add further entries and reports an error. [Default: unset]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 					"iptablesBackend": {
@@ -2825,7 +3654,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 					},
 					"netlinkTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "NetlinkTimeout is the timeout for netlink operations.  If NetlinkGetTimeout or NetlinkSetTimeout is unset, it defaults to the value of this field.\n\nDeprecated: set NetlinkGetTimeout and NetlinkSetTimeout instead.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"netlinkGetTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetlinkGetTimeout is the timeout for netlink operations that read dataplane state.  Reads are usually quicker than writes, but a long timeout can avoid false alarms on a slow node.  [Default: NetlinkTimeout if set, otherwise 10s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"netlinkSetTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetlinkSetTimeout is the timeout for netlink operations that write dataplane state.  Keeping it shorter than NetlinkGetTimeout avoids masking genuine write failures.  [Default: NetlinkTimeout if set, otherwise 10s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 					"metadataAddr": {
@@ -2851,11 +3693,26 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 					},
 					"interfacePrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InterfacePrefix is the interface name prefix that identifies workload endpoints and so distinguishes them from host endpoint interfaces. Note: in environments other than bare metal, the orchestrators configure this appropriately. For example our Kubernetes and Docker integrations set the 'cali' value, and our OpenStack integration sets the 'tap' value. [Default: cali]",
+							Description: "InterfacePrefix is the interface name prefix that identifies workload endpoints and so distinguishes them from host endpoint interfaces. Note: in environments other than bare metal, the orchestrators configure this appropriately. For example our Kubernetes and Docker integrations set the 'cali' value, and our OpenStack integration sets the 'tap' value. [Default: cali]\n\nDeprecated: use InterfacePrefixes instead. InterfacePrefix is ignored when InterfacePrefixes is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"interfacePrefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfacePrefixes is the list of interface name prefixes that identify workload endpoints and so distinguish them from host endpoint interfaces. Multiple prefixes are needed when more than one CNI plugin creates workload interfaces on the same host. When set, this takes precedence over InterfacePrefix. [Default: unset]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"interfaceExclude": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InterfaceExclude is a comma-separated list of interfaces that Felix should exclude when monitoring for host endpoints. The default value ensures that Felix ignores Kubernetes' IPVS dummy interface, which is used internally by kube-proxy. If you want to exclude multiple interface names using a single value, the list supports regular expressions. For regular expressions you must wrap the value with '/'. For example having values '/^kube/,veth1' will exclude all interfaces that begin with 'kube' and also the interface 'veth1'. [Default: kube-ipvs0]",
@@ -2889,6 +3746,13 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format: "",
 						},
 					},
+					"iptablesFilterDenyAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IptablesFilterDenyAction controls the iptables action that Felix uses for traffic that is denied by policy. \"Drop\" silently discards the packet, which makes the host harder to fingerprint, whereas \"Reject\" answers with a TCP reset or ICMP unreachable so that connections fail fast, which is easier to debug. [Default: Drop]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"logPrefix": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LogPrefix is the log prefix that Felix uses when rendering LOG rules. [Default: calico-packet]",
@@ -2944,6 +3808,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"ipipMTUIPv6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPIPMTUIPv6 is the MTU to set on the IPv6 IPIP tunnel device.  It should be the link MTU minus the IPv6 encapsulation overhead of 40 bytes, and must be at least 1280, the minimum MTU for IPv6. [Default: unset - derived from the link MTU]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ipipTunnelAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPIPTunnelAddress, if set, is the IPv4 address that Felix assigns to the IPIP tunnel device instead of one allocated by Calico IPAM.  This is advanced usage: the address is not tracked by IPAM, so it may conflict with addresses that IPAM assigns to other nodes or workloads.  [Default: unset - allocated by IPAM]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"vxlanEnabled": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
@@ -2969,6 +3847,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format: "int32",
 						},
 					},
+					"vxlanMTUIPv6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VXLANMTUIPv6 is the MTU to set on the IPv6 VXLAN tunnel device.  It should be the link MTU minus the VXLAN over IPv6 encapsulation overhead of 70 bytes, and must be at least 1280, the minimum MTU for IPv6. [Default: unset - derived from the link MTU]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"vxlanTunnelAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VXLANTunnelAddress, if set, is the IPv4 address that Felix assigns to the VXLAN tunnel device instead of one allocated by Calico IPAM.  This is advanced usage: the address is not tracked by IPAM, so it may conflict with addresses that IPAM assigns to other nodes or workloads.  [Default: unset - allocated by IPAM]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"allowVXLANPacketsFromWorkloads": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AllowVXLANPacketsFromWorkloads controls whether Felix will add a rule to drop VXLAN encapsulated traffic from workloads [Default: false]",
@@ -2995,6 +3887,13 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"reportingMechanism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReportingMechanism controls how Felix reports its status.  \"CRDStatus\" writes status to the datastore, which requires Felix to have write access to Calico's status resources.  \"ConfigMap\" writes status to a node-specific ConfigMap instead, which only requires write access to ConfigMaps and so suits restricted RBAC profiles.  \"DisableReporting\" turns status reporting off, so Felix needs neither permission. [Default: CRDStatus]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"endpointReportingEnabled": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
@@ -3037,6 +3936,26 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format: "int32",
 						},
 					},
+					"healthCheckTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCheckTimeout is the write timeout for responses from Felix's health endpoint, which stops a stuck health check from hanging the caller's probe. Set to 0 to disable the timeout; must not be negative. [Default: 10s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"healthTimeoutOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthTimeoutOverrides allows the internal health timeouts of individual Felix components to be overridden. The map is keyed on the component name, for example \"dataplane\", \"policySync\" or \"ipsetsSyncer\", and each value must be a positive duration. [Default: unset - each component uses its built-in timeout]",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+									},
+								},
+							},
+						},
+					},
 					"prometheusMetricsEnabled": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PrometheusMetricsEnabled enables the Prometheus metrics server in Felix if set to true. [Default: false]",
@@ -3098,6 +4017,28 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format: "",
 						},
 					},
+					"prometheusMetricsTLSMinVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusMetricsTLSMinVersion is the minimum TLS version accepted by the Prometheus metrics server when TLS credentials are configured. [Default: TLSv12]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prometheusMetricsTLSCipherSuites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusMetricsTLSCipherSuites restricts the cipher suites accepted by the Prometheus metrics server when TLS credentials are configured. Each entry is a Go TLS cipher suite name, for example \"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384\". [Default: unset - Go's default cipher suites]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"failsafeInboundHostPorts": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FailsafeInboundHostPorts is a list of UDP/TCP ports and CIDRs that Felix will allow incoming traffic to host endpoints on irrespective of the security policy. This is useful to avoid accidentally cutting off a host with incorrect configuration. For back-compatibility, if the protocol is not specified, it defaults to \"tcp\". If a CIDR is not specified, it will allow traffic from all addresses. To disable all inbound host ports, use the value none. The default value allows ssh access and DHCP. [Default: tcp:22, udp:68, tcp:179, tcp:2379, tcp:2380, tcp:6443, tcp:6666, tcp:6667]",
@@ -3207,6 +4148,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"ecmpEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ECMPEnabled controls whether Felix programs equal-cost multipath routes, with multiple next hops, instead of a single next hop per route. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ecmpHashMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ECMPHashMode selects how the kernel hashes packets across the next hops of an equal-cost multipath route. One of \"Layer3\", \"Layer4\" or \"Layer3AndPort\".  May only be set when ECMPEnabled is true. [Default: Layer3]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"externalNodesList": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalNodesCIDRList is a list of CIDR's of external-non-calico-nodes which may source tunnel traffic and have the tunneled traffic be accepted at calico nodes.",
@@ -3273,7 +4228,7 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 					},
 					"dropActionOverride": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DropActionOverride overrides the Drop action in Felix, optionally changing the behavior to Accept, and optionally adding Log. Possible values are Drop, LogAndDrop, Accept, LogAndAccept. [Default: Drop]",
+							Description: "DropActionOverride overrides the Drop action in Felix, optionally changing the behavior to Accept or Reject, and optionally adding Log. Reject sends an ICMP unreachable message back to the sender instead of silently dropping the packet. Possible values are Drop, LogAndDrop, Accept, LogAndAccept, Reject, LogAndReject. [Default: Drop]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3300,6 +4255,26 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"debugCPUProfilePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugCPUProfilePath is the path to which Felix writes pprof CPU profiles, which lets a CPU profile be captured without restarting Felix.  This is a debug setting that should never be set in production.  [Default: unset - CPU profiling disabled]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"debugCPUProfileInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugCPUProfileInterval is how long each CPU profile written to DebugCPUProfilePath samples for.  This is a debug setting that should never be set in production.  [Default: 30s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"debugPanicOnWriteFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugPanicOnWriteFailure causes Felix to panic, rather than retry, when a write to the dataplane fails.  This is a debug setting that should never be set in production.  [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"iptablesNATOutgoingInterfaceFilter": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -3348,114 +4323,334 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
-					"bpfDataIfacePattern": {
+					"bpfLogFilters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFDataIfacePattern is a regular expression that controls which interfaces Felix should attach BPF programs to in order to catch traffic to/from the network.  This needs to match the interfaces that Calico workload traffic flows over as well as any interfaces that handle incoming traffic to nodeports and services from outside the cluster.  It should not match the workload interfaces (usually named cali...).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BPFLogFilters, in BPF dataplane mode, sets the log level for individual BPF programs.  Each key is the name of a BPF program, for example \"cali_from_wep\", and each value is one of \"Off\", \"Info\" or \"Debug\".  Programs listed here use the given log level instead of BPFLogLevel.  [Default: unset]",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"bpfConnectTimeLoadBalancingEnabled": {
+					"bpfInterfaceLogFilters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFConnectTimeLoadBalancingEnabled when in BPF mode, controls whether Felix installs the connection-time load balancer.  The connect-time load balancer is required for the host to be able to reach Kubernetes services and it improves the performance of pod-to-service connections.  The only reason to disable it is for debugging purposes.  [Default: true]",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "BPFInterfaceLogFilters, in BPF dataplane mode, sets the log level of the BPF programs attached to particular interfaces.  Each key is a regular expression that is matched against interface names and each value is one of \"Off\", \"Info\" or \"Debug\".  Where more than one pattern matches an interface, the longest pattern wins. Interfaces that match no pattern use BPFLogLevel, and a program listed in BPFLogFilters uses that level whatever its interface.  [Default: unset]",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"bpfExternalServiceMode": {
+					"bpfPolicyDebugEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFExternalServiceMode in BPF mode, controls how connections from outside the cluster to services (node ports and cluster IPs) are forwarded to remote workloads.  If set to \"Tunnel\" then both request and response traffic is tunneled to the remote node.  If set to \"DSR\", the request traffic is tunneled but the response traffic is sent directly from the remote node.  In \"DSR\" mode, the remote node appears to use the IP of the ingress node; this requires a permissive L2 network.  [Default: Tunnel]",
-							Type:        []string{"string"},
+							Description: "BPFPolicyDebugEnabled when true, Felix emits trace events for BPF policy decisions.  When BPFLogLevel is \"Off\", only the policy decision events are emitted, which makes it possible to debug policy drops without flooding the kernel trace pipe.  [Default: false]",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"bpfExtToServiceConnmark": {
+					"bpfPolicyDebugSamplingRate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFExtToServiceConnmark in BPF mode, control a 32bit mark that is set on connections from an external client to a local service. This mark allows us to control how packets of that connection are routed within the host and how is routing intepreted by RPF check. [Default: 0]",
+							Description: "BPFPolicyDebugSamplingRate controls the fraction of packets for which policy decision trace events are emitted when BPFPolicyDebugEnabled is true: one in every N packets is traced.  [Default: 1]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"bpfKubeProxyIptablesCleanupEnabled": {
+					"bpfDataIfacePattern": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFKubeProxyIptablesCleanupEnabled, if enabled in BPF mode, Felix will proactively clean up the upstream Kubernetes kube-proxy's iptables chains.  Should only be enabled if kube-proxy is not running.  [Default: true]",
-							Type:        []string{"boolean"},
+							Description: "BPFDataIfacePattern is a regular expression that controls which interfaces Felix should attach BPF programs to in order to catch traffic to/from the network.  This needs to match the interfaces that Calico workload traffic flows over as well as any interfaces that handle incoming traffic to nodeports and services from outside the cluster.  It should not match the workload interfaces (usually named cali...).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bpfKubeProxyMinSyncPeriod": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BPFKubeProxyMinSyncPeriod, in BPF mode, controls the minimum time between updates to the dataplane for Felix's embedded kube-proxy.  Lower values give reduced set-up latency.  Higher values reduce Felix CPU usage by batching up more work.  [Default: 1s]",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"bpfKubeProxyEndpointSlicesEnabled": {
+					"bpfConnectTimeLoadBalancingEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BPFKubeProxyEndpointSlicesEnabled in BPF mode, controls whether Felix's embedded kube-proxy accepts EndpointSlices or not.",
+							Description: "BPFConnectTimeLoadBalancingEnabled when in BPF mode, controls whether Felix installs the connection-time load balancer.  The connect-time load balancer is required for the host to be able to reach Kubernetes services and it improves the performance of pod-to-service connections.  The only reason to disable it is for debugging purposes.  [Default: true]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"syslogReporterNetwork": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"syslogReporterAddress": {
+					"bpfCTLBHeartbeatEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "BPFCTLBHeartbeatEnabled in BPF mode, controls whether the connect-time load balancer checks the health of service endpoints with periodic heartbeats and skips endpoints that have not responded within BPFCTLBHeartbeatInterval, rather than relying only on endpoint slice updates.  Has no effect unless BPFConnectTimeLoadBalancingEnabled is true.  [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"ipsecMode": {
+					"bpfCTLBHeartbeatInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecMode controls which mode IPSec is operating on. Default value means IPSec is not enabled. [Default: \"\"]",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BPFCTLBHeartbeatInterval in BPF mode, is the interval between connect-time load balancer heartbeats and the time within which an endpoint must respond to remain eligible.  Only used when BPFCTLBHeartbeatEnabled is true.  [Default: 10s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"ipsecAllowUnsecuredTraffic": {
+					"bpfHostConntrackBypass": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecAllowUnsecuredTraffic controls whether non-IPsec traffic is allowed in addition to IPsec traffic. Enabling this negates the anti-spoofing protections of IPsec but it is useful when migrating to/from IPsec. [Default: false]",
+							Description: "BPFHostConntrackBypass controls whether traffic of host-networked workloads bypasses Linux conntrack in BPF mode, which reduces the per-packet overhead for high-throughput workloads.  Only takes effect when BPFEnabled is true.  Warning: changing this setting may affect the correctness of policy enforcement for host-networked workloads and should only be done in trusted environments.  [Default: true]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"ipsecIKEAlgorithm": {
+					"bpfConntrackMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecIKEAlgorithm sets IPSec IKE algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-prfsha256-ecp256]",
+							Description: "BPFConntrackMode in BPF mode, controls which connection tracking implementation Felix uses.  \"BPFOnly\" uses the BPF conntrack tables, \"NFConntrack\" falls back to Linux netfilter conntrack, which works around kernels whose BPF conntrack support is incomplete, and \"Auto\" probes the kernel and picks the best option.  Has no effect when BPFEnabled is false.  [Default: Auto]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipsecESPAlgorithm": {
+					"bpfExternalServiceMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecESAlgorithm sets IPSec ESP algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-ecp256]",
+							Description: "BPFExternalServiceMode in BPF mode, controls how connections from outside the cluster to services (node ports and cluster IPs) are forwarded to remote workloads.  If set to \"Tunnel\" then both request and response traffic is tunneled to the remote node.  If set to \"DSR\", the request traffic is tunneled but the response traffic is sent directly from the remote node.  In \"DSR\" mode, the remote node appears to use the IP of the ingress node; this requires a permissive L2 network.  [Default: Tunnel]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipsecLogLevel": {
+					"bpfExternalServiceModeNodePort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecLogLevel controls log level for IPSec components. Set to None for no logging. A generic log level terminology is used [None, Notice, Info, Debug, Verbose]. [Default: Info]",
+							Description: "BPFExternalServiceModeNodePort in BPF mode, overrides BPFExternalServiceMode for traffic to node ports.  Node port traffic may return via a different NIC than the one it arrived on, so it can need a different mode from cluster IP traffic.  Accepts the same values as BPFExternalServiceMode.  [Default: unset - use BPFExternalServiceMode]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipsecPolicyRefreshInterval": {
+					"bpfExcludeCIDRsFromNAT": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPSecPolicyRefreshInterval is the interval at which Felix will check the kernel's IPsec policy tables and repair any inconsistencies. [Default: 600s]",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "BPFExcludeCIDRsFromNAT in BPF mode, is a list of CIDRs whose traffic bypasses the BPF NAT tables, for example, load balancer VIPs that are announced by MetalLB or reached through direct routing.  Traffic to these CIDRs is not NATted to a service backend, so BPFExternalServiceMode and BPFExternalServiceModeNodePort have no effect on it.  [Default: unset]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"flowLogsFlushInterval": {
+					"bpfExtToServiceConnmark": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFlushInterval configures the interval at which Felix exports flow logs.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "BPFExtToServiceConnmark in BPF mode, control a 32bit mark that is set on connections from an external client to a local service. This mark allows us to control how packets of that connection are routed within the host and how is routing intepreted by RPF check. [Default: 0]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"flowLogsEnableHostEndpoint": {
+					"bpfKubeProxyIptablesCleanupEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFKubeProxyIptablesCleanupEnabled, if enabled in BPF mode, Felix will proactively clean up the upstream Kubernetes kube-proxy's iptables chains.  Should only be enabled if kube-proxy is not running.  [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bpfKubeProxyMinSyncPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFKubeProxyMinSyncPeriod, in BPF mode, controls the minimum time between updates to the dataplane for Felix's embedded kube-proxy.  Lower values give reduced set-up latency.  Higher values reduce Felix CPU usage by batching up more work.  [Default: 1s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"bpfKubeProxyEndpointSlicesEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFKubeProxyEndpointSlicesEnabled in BPF mode, controls whether Felix's embedded kube-proxy accepts EndpointSlices or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bpfKubeProxyEndpointHealthzPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFKubeProxyEndpointHealthzPort in BPF mode, is the port on which Felix serves a health endpoint for its embedded kube-proxy.  The endpoint reports healthy while the last kube-proxy sync succeeded within twice BPFKubeProxyMinSyncPeriod.  Must not be the same as HealthPort or the Prometheus ports.  [Default: unset - no health endpoint]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfKubeProxyNodePortRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFKubeProxyNodePortRanges in BPF mode, holds the list of port ranges that Felix's embedded kube-proxy uses for service node ports.  When set, it takes precedence over KubeNodePortRanges in BPF mode. [Default: unset - use KubeNodePortRanges]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/lib/numorstring.Port"),
+									},
+								},
+							},
+						},
+					},
+					"bpfForceTrackPacketsFromIfaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFForceTrackPacketsFromIfaces in BPF mode, forces traffic from these interfaces to go through conntrack, even where the BPF dataplane would otherwise bypass it.  Each entry is an interface name, which may include a \"*\" wildcard, for example \"eth0\" or \"bond*\".  [Default: unset]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bpfMapSizeConntrack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMapSizeConntrack sets the size for the conntrack map.  This map must be large enough to hold an entry for each active connection.  Warning: changing the size of the conntrack map can cause disruption. [Default: 512000]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfMapSizeNATFrontend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMapSizeNATFrontend sets the size for the nat front end map. FrontendMap should be large enough to hold an entry for each nodeport, external IP and each port in each service.  [Default: 65536]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfMapSizeNATBackend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMapSizeNATBackend sets the size for nat back end map. This is the total number of endpoints. This is mostly more than the size of the number of services.  [Default: 262144]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfMapSizeNATAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMapSizeNATAffinity sets the size of the map used to track service affinity, i.e. the backend that each client was last sent to for services with session affinity.  [Default: 65536]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfMapSizeRoute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMapSizeRoute sets the size for the routes map.  The routes map should be large enough to hold one entry per workload and a handful of entries per host (enough to cover its own IPs and tunnel IPs).  [Default: 262144]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bpfCTMaxTrackedConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFCTMaxTrackedConnections in BPF mode, limits the number of connections that Felix tracks in the BPF conntrack table.  Once the limit is reached, Felix reports a BPF_CT_OVERFLOW warning metric and new connections are rejected.  This provides back-pressure before the kernel conntrack map itself is full.  [Default: unset - no limit]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"syslogReporterNetwork": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"syslogReporterAddress": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ipsecMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecMode controls which mode IPSec is operating on. Default value means IPSec is not enabled. [Default: \"\"]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipsecAllowUnsecuredTraffic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecAllowUnsecuredTraffic controls whether non-IPsec traffic is allowed in addition to IPsec traffic. Enabling this negates the anti-spoofing protections of IPsec but it is useful when migrating to/from IPsec. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ipsecStrictMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecStrictMode, when enabled, makes Felix install an XFRM policy that drops any traffic arriving on an IPSec-configured interface without a matching security association, in addition to the standard allow rules. Requires IPSecMode to be set. Enabling this on a running cluster drops traffic between nodes that have not yet established IPSec tunnels, so it should only be enabled once IPSec is up on all nodes. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ipsecIKEAlgorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecIKEAlgorithm sets IPSec IKE algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-prfsha256-ecp256]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipsecESPAlgorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecESAlgorithm sets IPSec ESP algorithm. Default is NIST suite B recommendation. [Default: aes128gcm16-ecp256]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipsecLogLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecLogLevel controls log level for IPSec components. Set to None for no logging. A generic log level terminology is used [None, Notice, Info, Debug, Verbose]. [Default: Info]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipsecPolicyRefreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecPolicyRefreshInterval is the interval at which Felix will check the kernel's IPsec policy tables and repair any inconsistencies. [Default: 600s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ipsecPeerCertVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecPeerCertVerification controls how Felix verifies the certificates presented by IPSec peers. CAOnly accepts any certificate signed by the cluster CA. PeerName additionally requires the certificate CN to match the peer's node name. PeerSAN additionally requires a Subject Alternative Name of the certificate to match the peer. [Default: CAOnly]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipsecReplayWindowSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecReplayWindowSize sets the size of the IPSec anti-replay window, in packets. Larger windows avoid spurious drops on high-latency links where packets are reordered. [Default: 32]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ipsecDPDTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecDPDTimeout is the time after which an IPSec peer that has not responded to dead peer detection is considered dead. [Default: 30s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ipsecDPDAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPSecDPDAction controls the action taken when dead peer detection detects that an IPSec peer is dead. Accepted values are \"clear\", \"hold\" and \"restart\". [Default: clear]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsFlushInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFlushInterval configures the interval at which Felix exports flow logs.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"flowLogsMaxBytesPerFlush": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsMaxBytesPerFlush limits the number of buffered flow log bytes that Felix writes in a single flush. When the buffered logs reach this size before FlowLogsFlushInterval has elapsed, Felix flushes early. [Default: unset, meaning no limit]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsExportBatchTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsExportBatchTimeout is the maximum time that Felix holds a partial batch of flow logs before exporting it to an external sink, even if the batch has not reached its size threshold. Set to 0 to export each flow log immediately, without batching. [Default: 5s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"flowLogsEnableHostEndpoint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FlowLogsEnableHostEndpoint enables Flow logs reporting for HostEndpoints.",
 							Type:        []string{"boolean"},
@@ -3469,6 +4664,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"flowLogsDNSAggregationEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsDNSAggregationEnabled controls whether DNS names are included in the aggregation keys of Flow log entries. Disabling this reduces the number of distinct Flow log entries when DNS answers change frequently. When FlowLogsEnableNetworkSets is enabled, endpoints that match a network set are still identified by the network set, whatever the value of this setting. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsEnableDNSPolicyViolation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsEnableDNSPolicyViolation enables Flow logs reporting for connections that are denied by DNS policy, attributed to the policy rule that denied them. Disabling this suppresses those entries, which can be useful when their volume is very high. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"flowLogsMaxOriginalIPsIncluded": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FlowLogsMaxOriginalIPsIncluded specifies the number of unique IP addresses (if relevant) that should be included in Flow logs.",
@@ -3497,553 +4706,1182 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
-					"flowLogsFileEnabled": {
+					"flowLogsFileEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileEnabled when set to true, enables logging flow logs to a file. If false no flow logging to file will occur.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxFiles sets the number of log files to keep.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileMaxFileSizeMB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxFileSizeMB sets the max size in MB of flow logs files before rotation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileCompressionEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileCompressionEnabled when set to true, compresses flow log files with gzip after they are rotated. The active log file is never compressed. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileCompressionLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileCompressionLevel sets the gzip compression level used for flow log files, from 1 (fastest) to 9 (best compression). This is ignored unless FlowLogsFileCompressionEnabled is true. [Default: 6]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileDirectory sets the directory where flow logs files are stored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileIncludeLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileIncludeLabels is used to configure if endpoint labels are included in a Flow log entry written to file.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileIncludePolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileIncludePolicies is used to configure if policy information are included in a Flow log entry written to file.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsPolicyFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsPolicyFields selects which parts of the policy information are included in a Flow log entry written to file. Accepted values are tier_name, policy_name, rule_action, rule_index and namespace. When unset, all policy information is included. FlowLogsFileIncludePolicies must be enabled for this field to take effect.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"flowLogsFileIncludeService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileIncludeService is used to configure if the destination service is included in a Flow log entry written to file. The service information can only be included if the flow was explicitly determined to be directed at the service (e.g. when the pre-DNAT destination corresponds to the service ClusterIP and port).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileIncludeProcess": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileIncludeProcess is used to configure if process information is included in a Flow log entry written to file. This only has an effect when FlowLogsCollectProcessInfo is enabled. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileMaxProcessNameLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxProcessNameLength truncates process names and paths in Flow log entries written to file to this many characters. Set to 0 for no limit. [Default: 0]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileAggregationKindForAllowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileAggregationKindForAllowed is used to choose the type of aggregation for flow log entries created for allowed connections. [Default: 2 - pod prefix name based aggregation]. Accepted values are 0, 1 and 2. 0 - No aggregation 1 - Source port based aggregation 2 - Pod prefix name based aggreagation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileAggregationKindForDenied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileAggregationKindForDenied is used to choose the type of aggregation for flow log entries created for denied connections. [Default: 1 - source port based aggregation]. Accepted values are 0, 1, 2 and 3. 0 - No aggregation 1 - Source port based aggregation 2 - Pod prefix name based aggregation. 3 - No destination ports based aggregation",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileEnabledForAllowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileEnabledForAllowed is used to enable/disable flow logs entries created for allowed connections. Default is true. This parameter only takes effect when FlowLogsFileReporterEnabled is set to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileEnabledForDenied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileEnabledForDenied is used to enable/disable flow logs entries created for denied flows. Default is true. This parameter only takes effect when FlowLogsFileReporterEnabled is set to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsDynamicAggregationEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsDynamicAggregationEnabled is used to enable/disable dynamically changing aggregation levels. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsPositionFilePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsPositionFilePath is used specify the position of the external pipeline that reads flow logs. Default is /var/log/calico/flows.log.pos. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsAggregationThresholdBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsAggregationThresholdBytes is used specify how far behind the external pipeline that reads flow logs can be. Default is 8192 bytes. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFilePerFlowProcessLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFilePerFlowProcessLimit, is used to specify the maximum number of flow log entries with distinct process information beyond which process information will be aggregated. [Default: 2]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsS3Enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3Enabled when set to true, enables exporting flow logs to an S3 bucket. FlowLogsS3BucketName and FlowLogsS3Region must be set when this is enabled. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3BucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3BucketName is the name of the S3 bucket that flow logs are exported to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3Region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3Region is the region of the S3 bucket that flow logs are exported to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3Prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3Prefix is the key prefix under which flow logs are written in the S3 bucket. [Default: \"\"]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3EncryptionKeyARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3EncryptionKeyARN is the ARN of the KMS key used to encrypt flow logs written to the S3 bucket. When unset, the bucket's default encryption is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3EndpointURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3EndpointURL overrides the S3 endpoint, for use with S3-compatible stores other than AWS. When unset, the AWS endpoint for FlowLogsS3Region is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3AggregationKindForAllowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3AggregationKindForAllowed is used to choose the type of aggregation for flow log entries exported to S3 for allowed connections. Accepts the same values as FlowLogsFileAggregationKindForAllowed. [Default: 2 - pod prefix name based aggregation].",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsS3AggregationKindForDenied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3AggregationKindForDenied is used to choose the type of aggregation for flow log entries exported to S3 for denied connections. Accepts the same values as FlowLogsFileAggregationKindForDenied. [Default: 1 - source port based aggregation].",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsS3EnabledForAllowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3EnabledForAllowed is used to enable/disable exporting flow log entries to S3 for allowed connections. Default is true. This parameter only takes effect when FlowLogsS3Enabled is set to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsS3EnabledForDenied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsS3EnabledForDenied is used to enable/disable exporting flow log entries to S3 for denied connections. Default is true. This parameter only takes effect when FlowLogsS3Enabled is set to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"windowsFlowLogsFileDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowsFlowLogsFileDirectory sets the directory where flow logs files are stored on Windows nodes. [Default: \"c:\\TigeraCalico\\flowlogs\"].",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowsFlowLogsMaxFileSizeMB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowsFlowLogsMaxFileSizeMB sets the max size in MB of flow logs files on Windows nodes before rotation. When set, it overrides FlowLogsFileMaxFileSizeMB on Windows nodes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windowsFlowLogsMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowsFlowLogsMaxFiles sets the number of flow log files to keep on Windows nodes. When set, it overrides FlowLogsFileMaxFiles on Windows nodes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windowsFlowLogsPositionFilePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowsFlowLogsPositionFilePath is used to specify the position of the external pipeline that reads flow logs on Windows nodes. [Default: \"c:\\TigeraCalico\\flowlogs\\flows.log.pos\"]. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowsStatsDumpFilePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowsStatsDumpFilePath is used to specify the path of the stats dump file on Windows nodes. [Default: \"c:\\TigeraCalico\\stats\\dump\"]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowsDnsCacheFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the file that Felix uses to preserve learnt DNS information when restarting. [Default: \"c:\\TigeraCalico\\felix-dns-cache.txt\"].",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowsDnsExtraTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extra time to keep IPs and alias names that are learnt from DNS, in addition to each name or IP's advertised TTL. The default value is 120s which is same as the default value of ServicePointManager.DnsRefreshTimeout on .net framework. [Default: 120s].",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dnsTrustedServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The DNS servers that Felix should trust. Each entry here must be `<ip>[:<port>]` - indicating an explicit DNS server IP - or `k8s-service:[<namespace>/]<name>[:port]` - indicating a Kubernetes DNS service. `<port>` defaults to the first service port, or 53 for an IP, and `<namespace>` to `kube-system`. An IPv6 address with a port must use the square brackets convention, for example `[fd00:83a6::12]:5353`.Note that Felix (calico-node) will need RBAC permission to read the details of each service specified by a `k8s-service:...` form. [Default: \"k8s-service:kube-dns\"].",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dnsCacheFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the file that Felix uses to preserve learnt DNS information when restarting. [Default: \"/var/run/calico/felix-dns-cache.txt\"].",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsCacheSaveInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The periodic interval at which Felix saves learnt DNS information to the cache file. [Default: 60s].",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dnsCacheEpoch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "An arbitrary number that can be changed, at runtime, to tell Felix to discard all its learnt DNS information. [Default: 0].",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsExtraTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extra time to keep IPs and alias names that are learnt from DNS, in addition to each name or IP's advertised TTL. [Default: 0s].",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dnsLogsFlushInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFlushInterval configures the interval at which Felix exports DNS logs. [Default: 300s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dnsLogsFileEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileEnabled controls logging DNS logs to a file. If false no DNS logging to file will occur. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsLogsFileMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileMaxFiles sets the number of DNS log files to keep. [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsLogsFileMaxFileSizeMB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileMaxFileSizeMB sets the max size in MB of DNS log files before rotation. [Default: 100]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsLogsFileCompressionEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileCompressionEnabled when set to true, compresses DNS log files with gzip after they are rotated. The active log file is never compressed. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsLogsFileDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileDirectory sets the directory where DNS log files are stored. [Default: /var/log/calico/dnslogs]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsLogsFileIncludeLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileIncludeLabels is used to configure if endpoint labels are included in a DNS log entry written to file. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsLogsFileAggregationKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsFileAggregationKind is used to choose the type of aggregation for DNS log entries. [Default: 1 - client name prefix aggregation]. Accepted values are 0 and 1. 0 - No aggregation 1 - Aggregate over clients with the same name prefix",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsLogsFilePerNodeLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit on the number of DNS logs that can be emitted within each flush interval.  When this limit has been reached, Felix counts the number of unloggable DNS responses within the flush interval, and emits a WARNING log with that count at the same time as it flushes the buffered DNS logs.  [Default: 0, meaning no limit]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsLogsS3Enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsS3Enabled when set to true, enables exporting DNS logs to an S3 bucket. DNSLogsS3BucketName and DNSLogsS3Region must be set when this is enabled. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsLogsS3BucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsS3BucketName is the name of the S3 bucket that DNS logs are exported to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsLogsS3Region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsS3Region is the region of the S3 bucket that DNS logs are exported to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsLogsS3Prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsS3Prefix is the key prefix under which DNS logs are written in the S3 bucket. [Default: \"\"]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsLogsS3PerNodeLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit on the number of DNS logs that can be exported to S3 within each flush interval.  Has the same semantics as DNSLogsFilePerNodeLimit.  [Default: 0, meaning no limit]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsLogsLatency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSLogsLatency indicates to include measurements of DNS request/response latency in each DNS log. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"l7LogsFlushInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFlushInterval configures the interval at which Felix exports L7 logs. [Default: 300s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"l7LogsFileEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileEnabled controls logging L7 logs to a file. If false no L7 logging to file will occur. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileMaxFiles sets the number of L7 log files to keep. [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"l7LogsFileMaxFileSizeMB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileMaxFileSizeMB sets the max size in MB of L7 log files before rotation. [Default: 100]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"l7LogsFileCompressionEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileCompressionEnabled when set to true, compresses L7 log files with gzip after they are rotated. The active log file is never compressed. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileDirectory sets the directory where L7 log files are stored. [Default: /var/log/calico/l7logs]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationHTTPHeaderInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationHTTPHeaderInfo is used to choose the type of aggregation for HTTP header data on L7 log entries. [Default: ExcludeL7HTTPHeaderInfo - http header info removal]. Accepted values are IncludeL7HTTPHeaderInfo and ExcludeL7HTTPHeaderInfo. IncludeL7HTTPHeaderInfo - Include HTTP header data in the logs. ExcludeL7HTTPHeaderInfo - Aggregate over all other fields ignoring the user agent and log type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationUserAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationUserAgent is used to choose the type of aggregation for the user agent on L7 log entries. When set, it takes precedence over the user agent handling of L7LogsFileAggregationHTTPHeaderInfo. Accepted values are FullString, MajorVersion and Exclude. FullString - Include the full user agent string. MajorVersion - Include only the product name and major version of the user agent, e.g. \"Mozilla/5\". Exclude - Remove the user agent from the logs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationHTTPMethod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationHTTPMethod is used to choose the type of aggregation for the HTTP request method on L7 log entries. [Default: IncludeL7HTTPMethod - include the HTTP method]. Accepted values are IncludeL7HTTPMethod and ExcludeL7HTTPMethod. IncludeL7HTTPMethod - Include HTTP method in the logs. ExcludeL7HTTPMethod - Aggregate over all other fields ignoring the HTTP method.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationServiceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationServiceInfo is used to choose the type of aggregation for the service data on L7 log entries. [Default: IncludeL7ServiceInfo - include service data]. Accepted values are IncludeL7ServiceInfo and ExcludeL7ServiceInfo. IncludeL7ServiceInfo - Include service data in the logs. ExcludeL7ServiceInfo - Aggregate over all other fields ignoring the service name, namespace, and port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationDestinationInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationDestinationInfo is used to choose the type of aggregation for the destination metadata on L7 log entries. [Default: IncludeL7DestinationInfo - include destination metadata]. Accepted values are IncludeL7DestinationInfo and ExcludeL7DestinationInfo. IncludeL7DestinationInfo - Include destination metadata in the logs. ExcludeL7DestinationInfo - Aggregate over all other fields ignoring the destination aggregated name, namespace, and type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationSourceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationExcludeSourceInfo is used to choose the type of aggregation for the source metadata on L7 log entries. [Default: IncludeL7SourceInfoNoPort - include all source metadata except for the source port]. Accepted values are IncludeL7SourceInfo, IncludeL7SourceInfoNoPort, and ExcludeL7SourceInfo. IncludeL7SourceInfo - Include source metadata in the logs. IncludeL7SourceInfoNoPort - Include source metadata in the logs excluding the source port. ExcludeL7SourceInfo - Aggregate over all other fields ignoring the source aggregated name, namespace, and type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationResponseCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationResponseCode is used to choose the type of aggregation for the response code on L7 log entries. [Default: IncludeL7ResponseCode - include the response code]. Accepted values are IncludeL7ResponseCode and ExcludeL7ResponseCode. IncludeL7ResponseCode - Include the response code in the logs. ExcludeL7ResponseCode - Aggregate over all other fields ignoring the response code.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationTrimURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7LogsFileAggregationTrimURL is used to choose the type of aggregation for the url on L7 log entries. [Default: IncludeL7FullURL - include the full URL up to however many path components are allowed by L7LogsFileAggregationNumURLPath]. Accepted values are 0 and 1. IncludeL7FullURL - Include the full URL up to however many path components are allowed by L7LogsFileAggregationNumURLPath. TrimURLQuery - Aggregate over all other fields ignoring the query parameters on the URL. TrimURLQueryAndPath - Aggregate over all other fields and the base URL only. ExcludeL7URL - Aggregate over all other fields ignoring the URL entirely.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7LogsFileAggregationNumURLPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileEnabled when set to true, enables logging flow logs to a file. If false no flow logging to file will occur.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "L7LogsFileAggregationNumURLPath is used to choose the number of components in the url path to display. This allows for the url to be truncated in case parts of the path provide no value. Setting this value to negative will allow all parts of the path to be displayed. [Default: 5].",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"flowLogsFileMaxFiles": {
+					"l7LogsFileAggregationURLCharLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileMaxFiles sets the number of log files to keep.",
+							Description: "Limit on the length of the URL collected in L7 logs. When a URL length reaches this limit it is sliced off, and the sliced URL is sent to log storage. [Default: 250]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"flowLogsFileMaxFileSizeMB": {
+					"l7LogsFilePerNodeLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileMaxFileSizeMB sets the max size in MB of flow logs files before rotation.",
+							Description: "Limit on the number of L7 logs that can be emitted within each flush interval.  When this limit has been reached, Felix counts the number of unloggable L7 responses within the flush interval, and emits a WARNING log with that count at the same time as it flushes the buffered L7 logs. A value of 0 means no limit. [Default: 1500]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"flowLogsFileDirectory": {
+					"l7LogsFileRequestIDHeader": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileDirectory sets the directory where flow logs files are stored.",
+							Description: "L7LogsFileRequestIDHeader is the name of an HTTP request header, such as X-Request-ID or X-B3-TraceId, whose value Felix includes in each L7 log entry as the request_id field. This allows L7 logs to be correlated with distributed traces. When empty, no request ID is recorded. [Default: \"\"]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsFileIncludeLabels": {
+					"l7LogsS3Enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileIncludeLabels is used to configure if endpoint labels are included in a Flow log entry written to file.",
+							Description: "L7LogsS3Enabled when set to true, enables exporting L7 logs to an S3 bucket. L7LogsS3BucketName and L7LogsS3Region must be set when this is enabled. [Default: false]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"flowLogsFileIncludePolicies": {
+					"l7LogsS3BucketName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileIncludePolicies is used to configure if policy information are included in a Flow log entry written to file.",
-							Type:        []string{"boolean"},
+							Description: "L7LogsS3BucketName is the name of the S3 bucket that L7 logs are exported to.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsFileIncludeService": {
+					"l7LogsS3Region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileIncludeService is used to configure if the destination service is included in a Flow log entry written to file. The service information can only be included if the flow was explicitly determined to be directed at the service (e.g. when the pre-DNAT destination corresponds to the service ClusterIP and port).",
-							Type:        []string{"boolean"},
+							Description: "L7LogsS3Region is the region of the S3 bucket that L7 logs are exported to.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsFileAggregationKindForAllowed": {
+					"l7LogsS3Prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileAggregationKindForAllowed is used to choose the type of aggregation for flow log entries created for allowed connections. [Default: 2 - pod prefix name based aggregation]. Accepted values are 0, 1 and 2. 0 - No aggregation 1 - Source port based aggregation 2 - Pod prefix name based aggreagation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "L7LogsS3Prefix is the key prefix under which L7 logs are written in the S3 bucket. [Default: \"\"]",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"flowLogsFileAggregationKindForDenied": {
+					"l7LogsElasticsearchEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileAggregationKindForDenied is used to choose the type of aggregation for flow log entries created for denied connections. [Default: 1 - source port based aggregation]. Accepted values are 0, 1 and 2. 0 - No aggregation 1 - Source port based aggregation 2 - Pod prefix name based aggregation. 3 - No destination ports based aggregation",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "L7LogsElasticsearchEnabled when set to true, enables pushing L7 logs directly to Elasticsearch, without writing them to file first. L7LogsElasticsearchEndpoint must be set when this is enabled. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"flowLogsFileEnabledForAllowed": {
+					"l7LogsElasticsearchEndpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileEnabledForAllowed is used to enable/disable flow logs entries created for allowed connections. Default is true. This parameter only takes effect when FlowLogsFileReporterEnabled is set to true.",
-							Type:        []string{"boolean"},
+							Description: "L7LogsElasticsearchEndpoint is the URL of the Elasticsearch cluster that L7 logs are pushed to.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsFileEnabledForDenied": {
+					"l7LogsElasticsearchIndex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFileEnabledForDenied is used to enable/disable flow logs entries created for denied flows. Default is true. This parameter only takes effect when FlowLogsFileReporterEnabled is set to true.",
-							Type:        []string{"boolean"},
+							Description: "L7LogsElasticsearchIndex is the name of the Elasticsearch index that L7 logs are written to.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsDynamicAggregationEnabled": {
+					"windowsNetworkName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsDynamicAggregationEnabled is used to enable/disable dynamically changing aggregation levels. Default is true.",
-							Type:        []string{"boolean"},
+							Description: "WindowsNetworkName specifies which Windows HNS networks Felix should operate on.  The default is to match networks that start with \"calico\".  Supports regular expression syntax.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flowLogsPositionFilePath": {
+					"windowsVXLANEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsPositionFilePath is used specify the position of the external pipeline that reads flow logs. Default is /var/log/calico/flows.log.pos. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
-							Type:        []string{"string"},
+							Description: "WindowsVXLANEnabled overrides VXLANEnabled on Windows nodes. [Default: unset - VXLANEnabled applies]",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"flowLogsAggregationThresholdBytes": {
+					"windowsVXLANMTU": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsAggregationThresholdBytes is used specify how far behind the external pipeline that reads flow logs can be. Default is 8192 bytes. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
+							Description: "WindowsVXLANMTU is the MTU to set on the HNS VXLAN network on Windows nodes. When set, it overrides VXLANMTU on Windows nodes. [Default: unset - VXLANMTU applies]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"flowLogsFilePerFlowProcessLimit": {
+					"windowsVXLANPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlowLogsFilePerFlowProcessLimit, is used to specify the maximum number of flow log entries with distinct process information beyond which process information will be aggregated. [Default: 2]",
+							Description: "WindowsVXLANPort overrides VXLANPort on Windows nodes. [Default: unset - VXLANPort applies]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"windowsFlowLogsFileDirectory": {
+					"windowsVXLANVNI": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WindowsFlowLogsFileDirectory sets the directory where flow logs files are stored on Windows nodes. [Default: \"c:\\TigeraCalico\\flowlogs\"].",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "WindowsVXLANVNI overrides VXLANVNI on Windows nodes. [Default: unset - VXLANVNI applies]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"windowsFlowLogsPositionFilePath": {
+					"windowsBPFEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WindowsFlowLogsPositionFilePath is used to specify the position of the external pipeline that reads flow logs on Windows nodes. [Default: \"c:\\TigeraCalico\\flowlogs\\flows.log.pos\"]. This parameter only takes effect when FlowLogsDynamicAggregationEnabled is set to true.",
-							Type:        []string{"string"},
+							Description: "WindowsBPFEnabled, if enabled, Felix uses the eBPF for Windows APIs instead of HNS to implement the dataplane on Windows nodes.  It is independent of BPFEnabled, which only applies to Linux nodes.  This is an experimental feature.  [Default: false]",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"windowsStatsDumpFilePath": {
+					"windowsBPFLogLevel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WindowsStatsDumpFilePath is used to specify the path of the stats dump file on Windows nodes. [Default: \"c:\\TigeraCalico\\stats\\dump\"]",
+							Description: "WindowsBPFLogLevel controls the log level of the BPF programs on Windows nodes when WindowsBPFEnabled is true. One of \"Off\", \"Info\", or \"Debug\", as for BPFLogLevel.  [Default: Off].",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"windowsDnsCacheFile": {
+					"routeSource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the file that Felix uses to preserve learnt DNS information when restarting. [Default: \"c:\\TigeraCalico\\felix-dns-cache.txt\"].",
+							Description: "RouteSource configures where Felix gets its routing information. - WorkloadIPs: use workload endpoints to construct routes. - CalicoIPAM: the default - use IPAM data to construct routes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"windowsDnsExtraTTL": {
+					"routeTableRange": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extra time to keep IPs and alias names that are learnt from DNS, in addition to each name or IP's advertised TTL. The default value is 120s which is same as the default value of ServicePointManager.DnsRefreshTimeout on .net framework. [Default: 120s].",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Calico programs additional Linux route tables for various purposes.  RouteTableRange specifies the indices of the route tables that Calico should use.\n\nDeprecated: Use RouteTableRanges instead.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.RouteTableRange"),
 						},
 					},
-					"dnsTrustedServers": {
+					"routeTableRanges": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The DNS servers that Felix should trust. Each entry here must be `<ip>[:<port>]` - indicating an explicit DNS server IP - or `k8s-service:[<namespace>/]<name>[:port]` - indicating a Kubernetes DNS service. `<port>` defaults to the first service port, or 53 for an IP, and `<namespace>` to `kube-system`. An IPv6 address with a port must use the square brackets convention, for example `[fd00:83a6::12]:5353`.Note that Felix (calico-node) will need RBAC permission to read the details of each service specified by a `k8s-service:...` form. [Default: \"k8s-service:kube-dns\"].",
+							Description: "Calico programs additional Linux route tables for various purposes.  RouteTableRanges specifies a set of table index ranges that Calico should use.  The ranges must not overlap, each range must have Min <= Max, and indices must be between 1 and 2147483647 (tables 253-255 are reserved by the kernel).  Takes precedence over RouteTableRange.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.RouteTableRange"),
 									},
 								},
 							},
 						},
 					},
-					"dnsCacheFile": {
+					"egressIPSupport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the file that Felix uses to preserve learnt DNS information when restarting. [Default: \"/var/run/calico/felix-dns-cache.txt\"].",
+							Description: "EgressIPSupport defines three different support modes for egress IP function. [Default: Disabled] - Disabled:                    Egress IP function is disabled. - EnabledPerNamespace:         Egress IP function is enabled and can be configured on a per-namespace basis;\n                               per-pod egress annotations are ignored.\n- EnabledPerNamespaceOrPerPod: Egress IP function is enabled and can be configured per-namespace or per-pod,\n                               with per-pod egress annotations overriding namespace annotations.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dnsCacheSaveInterval": {
+					"egressIPVXLANPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The periodic interval at which Felix saves learnt DNS information to the cache file. [Default: 60s].",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "EgressIPVXLANPort is the port number of vxlan tunnel device for egress traffic. [Default: 4790]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dnsCacheEpoch": {
+					"egressIPVXLANVNI": {
 						SchemaProps: spec.SchemaProps{
-							Description: "An arbitrary number that can be changed, at runtime, to tell Felix to discard all its learnt DNS information. [Default: 0].",
+							Description: "EgressIPVXLANVNI is the VNI ID of vxlan tunnel device for egress traffic. [Default: 4097]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"dnsExtraTTL": {
+					"egressIPRoutingRulePriority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extra time to keep IPs and alias names that are learnt from DNS, in addition to each name or IP's advertised TTL. [Default: 0s].",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "EgressIPRoutingRulePriority controls the priority value to use for the egress IP routing rule. [Default: 100]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dnsLogsFlushInterval": {
+					"egressIPHealthTimeoutDatastore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFlushInterval configures the interval at which Felix exports DNS logs. [Default: 300s]",
+							Description: "EgressIPHealthTimeoutDatastore is the time after which an egress gateway is treated as failed if its health has not been refreshed in the datastore. [Default: 90s]",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"dnsLogsFileEnabled": {
+					"egressIPHealthTimeoutICMP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileEnabled controls logging DNS logs to a file. If false no DNS logging to file will occur. [Default: false]",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "EgressIPHealthTimeoutICMP is the time after which an egress gateway is treated as failed if it has not responded to ICMP probes. Set to 0 to disable ICMP-based failure detection. [Default: 0]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"dnsLogsFileMaxFiles": {
+					"egressIPVXLANMTU": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileMaxFiles sets the number of DNS log files to keep. [Default: 5]",
+							Description: "EgressIPVXLANMTU is the MTU to use for the vxlan tunnel device for egress traffic, independently of the MTU of the workload VXLAN device. [Default: unset - derived from the host MTU]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"dnsLogsFileMaxFileSizeMB": {
+					"wireguardEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileMaxFileSizeMB sets the max size in MB of DNS log files before rotation. [Default: 100]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "WireguardEnabled controls whether Wireguard is enabled. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"dnsLogsFileDirectory": {
+					"wireguardListeningPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileDirectory sets the directory where DNS log files are stored. [Default: /var/log/calico/dnslogs]",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "WireguardListeningPort controls the listening port used by Wireguard. [Default: 51820]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dnsLogsFileIncludeLabels": {
+					"wireguardEnabledV6": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileIncludeLabels is used to configure if endpoint labels are included in a DNS log entry written to file. [Default: true]",
+							Description: "WireguardEnabledV6 controls whether Wireguard is enabled for IPv6 (encapsulating IPv6 traffic over an IPv6 underlay network). [Default: false]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"dnsLogsFileAggregationKind": {
+					"wireguardListeningPortV6": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsFileAggregationKind is used to choose the type of aggregation for DNS log entries. [Default: 1 - client name prefix aggregation]. Accepted values are 0 and 1. 0 - No aggregation 1 - Aggregate over clients with the same name prefix",
+							Description: "WireguardListeningPortV6 controls the listening port used by IPv6 Wireguard. [Default: 51821]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"dnsLogsFilePerNodeLimit": {
+					"wireguardRoutingRulePriority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit on the number of DNS logs that can be emitted within each flush interval.  When this limit has been reached, Felix counts the number of unloggable DNS responses within the flush interval, and emits a WARNING log with that count at the same time as it flushes the buffered DNS logs.  [Default: 0, meaning no limit]",
+							Description: "WireguardRoutingRulePriority controls the priority value to use for the Wireguard routing rule. [Default: 99]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"dnsLogsLatency": {
+					"wireguardInterfaceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSLogsLatency indicates to include measurements of DNS request/response latency in each DNS log. [Default: true]",
-							Type:        []string{"boolean"},
+							Description: "WireguardInterfaceName specifies the name to use for the Wireguard interface. [Default: wg.calico]",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFlushInterval": {
+					"wireguardInterfaceNameV6": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFlushInterval configures the interval at which Felix exports L7 logs. [Default: 300s]",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "WireguardInterfaceNameV6 specifies the name to use for the IPv6 Wireguard interface. [Default: wg-v6.calico]",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"l7LogsFileEnabled": {
+					"wireguardMTU": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileEnabled controls logging L7 logs to a file. If false no L7 logging to file will occur. [Default: true]",
+							Description: "WireguardMTU controls the MTU on the Wireguard interface. See Configuring MTU [Default: 1420]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"wireguardHostEncryptionEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardHostEncryptionEnabled controls whether Wireguard host-to-host encryption is enabled. [Default: false]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileMaxFiles": {
+					"wireguardAllowedCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileMaxFiles sets the number of L7 log files to keep. [Default: 5]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "WireguardAllowedCIDRs restricts the traffic that is routed through the Wireguard interface. When non-empty, only traffic to these CIDRs is sent over Wireguard; other inter-node traffic bypasses it. [Default: unset - all inter-node traffic uses Wireguard]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"l7LogsFileMaxFileSizeMB": {
+					"wireguardKeepAlive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileMaxFileSizeMB sets the max size in MB of L7 log files before rotation. [Default: 100]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "WireguardPersistentKeepAlive controls the Wireguard PersistentKeepalive option, the interval at which keepalive packets are sent to peers so that stateful firewalls do not drop idle tunnels. Must not be negative. Set to 0 to disable. [Default: 0]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"l7LogsFileDirectory": {
+					"wireguardPresharedKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileDirectory sets the directory where L7 log files are stored. [Default: /var/log/calico/l7logs]",
+							Description: "WireguardPresharedKey is the name of a Kubernetes Secret that holds a preshared key to add to the Wireguard handshake as an extra layer of authentication. This must be a Secret name, not the key itself. [Default: unset]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationHTTPHeaderInfo": {
+					"wireguardPresharedKeyNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationHTTPHeaderInfo is used to choose the type of aggregation for HTTP header data on L7 log entries. [Default: ExcludeL7HTTPHeaderInfo - http header info removal]. Accepted values are IncludeL7HTTPHeaderInfo and ExcludeL7HTTPHeaderInfo. IncludeL7HTTPHeaderInfo - Include HTTP header data in the logs. ExcludeL7HTTPHeaderInfo - Aggregate over all other fields ignoring the user agent and log type.",
+							Description: "WireguardPresharedKeyNamespace is the namespace of the Secret named by WireguardPresharedKey.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationHTTPMethod": {
+					"captureDir": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationHTTPMethod is used to choose the type of aggregation for the HTTP request method on L7 log entries. [Default: IncludeL7HTTPMethod - include the HTTP method]. Accepted values are IncludeL7HTTPMethod and ExcludeL7HTTPMethod. IncludeL7HTTPMethod - Include HTTP method in the logs. ExcludeL7HTTPMethod - Aggregate over all other fields ignoring the HTTP method.",
+							Description: "CaptureDir controls directory to store file capture. [Default: /var/log/calico/pcap]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationServiceInfo": {
+					"captureMaxSizeBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationServiceInfo is used to choose the type of aggregation for the service data on L7 log entries. [Default: IncludeL7ServiceInfo - include service data]. Accepted values are IncludeL7ServiceInfo and ExcludeL7ServiceInfo. IncludeL7ServiceInfo - Include service data in the logs. ExcludeL7ServiceInfo - Aggregate over all other fields ignoring the service name, namespace, and port.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CaptureMaxSizeBytes controls the max size of a file capture. [Default: 10000000]",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"l7LogsFileAggregationDestinationInfo": {
+					"captureRotationSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationDestinationInfo is used to choose the type of aggregation for the destination metadata on L7 log entries. [Default: IncludeL7DestinationInfo - include destination metadata]. Accepted values are IncludeL7DestinationInfo and ExcludeL7DestinationInfo. IncludeL7DestinationInfo - Include destination metadata in the logs. ExcludeL7DestinationInfo - Aggregate over all other fields ignoring the destination aggregated name, namespace, and type.",
+							Description: "CaptureRotationSeconds controls the time rotation of a packet capture. [Default: 3600]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"captureMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureMaxFiles controls number of rotated capture file to keep. [Default: 2]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"captureFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureFilter is a BPF filter expression, in libpcap syntax, that is applied to packet captures that do not specify their own filter. [Default: unset - capture all packets]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationSourceInfo": {
+					"captureSnapLen": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationExcludeSourceInfo is used to choose the type of aggregation for the source metadata on L7 log entries. [Default: IncludeL7SourceInfoNoPort - include all source metadata except for the source port]. Accepted values are IncludeL7SourceInfo, IncludeL7SourceInfoNoPort, and ExcludeL7SourceInfo. IncludeL7SourceInfo - Include source metadata in the logs. IncludeL7SourceInfoNoPort - Include source metadata in the logs excluding the source port. ExcludeL7SourceInfo - Aggregate over all other fields ignoring the source aggregated name, namespace, and type.",
+							Description: "CaptureSnapLen controls the maximum number of bytes captured from each packet. [Default: 65535]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pcapRemoteStreamEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PCAPRemoteStreamEndpoint is the <host>:<port> of a remote collector to which Felix streams captured packets, in pcapng format, as they are captured. Packets are streamed in addition to being written to CaptureDir, or instead of it when CaptureDir is empty. [Default: unset - streaming is disabled]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationResponseCode": {
+					"pcapRemoteStreamProtocol": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationResponseCode is used to choose the type of aggregation for the response code on L7 log entries. [Default: IncludeL7ResponseCode - include the response code]. Accepted values are IncludeL7ResponseCode and ExcludeL7ResponseCode. IncludeL7ResponseCode - Include the response code in the logs. ExcludeL7ResponseCode - Aggregate over all other fields ignoring the response code.",
+							Description: "PCAPRemoteStreamProtocol is the protocol used to stream captured packets to PCAPRemoteStreamEndpoint. Accepted values are \"UDP\", \"TCP\" or \"GRPC\". [Default: TCP]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationTrimURL": {
+					"pcapRemoteStreamCAFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationTrimURL is used to choose the type of aggregation for the url on L7 log entries. [Default: IncludeL7FullURL - include the full URL up to however many path components are allowed by L7LogsFileAggregationNumURLPath]. Accepted values are 0 and 1. IncludeL7FullURL - Include the full URL up to however many path components are allowed by L7LogsFileAggregationNumURLPath. TrimURLQuery - Aggregate over all other fields ignoring the query parameters on the URL. TrimURLQueryAndPath - Aggregate over all other fields and the base URL only. ExcludeL7URL - Aggregate over all other fields ignoring the URL entirely.",
+							Description: "PCAPRemoteStreamCAFile is the path to the CA bundle used to verify the certificate of PCAPRemoteStreamEndpoint. When set, the stream to the remote endpoint is secured with TLS.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationNumURLPath": {
+					"awsSrcDstCheck": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7LogsFileAggregationNumURLPath is used to choose the number of components in the url path to display. This allows for the url to be truncated in case parts of the path provide no value. Setting this value to negative will allow all parts of the path to be displayed. [Default: 5].",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Set source-destination-check on AWS EC2 instances. Accepted value must be one of \"DoNothing\", \"Enabled\" or \"Disabled\". [Default: DoNothing]",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"l7LogsFileAggregationURLCharLimit": {
+					"serviceLoopPrevention": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit on the length of the URL collected in L7 logs. When a URL length reaches this limit it is sliced off, and the sliced URL is sent to log storage. [Default: 250]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "When service IP advertisement is enabled, prevent routing loops to service IPs that are not in use, by dropping or rejecting packets that do not get DNAT'd by kube-proxy. Unless set to \"Disabled\", in which case such routing loops continue to be allowed. [Default: Drop]",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"l7LogsFilePerNodeLimit": {
+					"serviceLoopPreventionExemptCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit on the number of L7 logs that can be emitted within each flush interval.  When this limit has been reached, Felix counts the number of unloggable L7 responses within the flush interval, and emits a WARNING log with that count at the same time as it flushes the buffered L7 logs. A value of 0 means no limit. [Default: 1500]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ServiceLoopPreventionExemptCIDRs is a list of CIDRs that are exempt from ServiceLoopPrevention. Packets to service IPs within these CIDRs are not dropped or rejected, even if they do not get DNAT'd by kube-proxy. This is useful where real traffic is routed to service IPs, for example with MetalLB. [Default: unset]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"windowsNetworkName": {
+					"serviceCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WindowsNetworkName specifies which Windows HNS networks Felix should operate on.  The default is to match networks that start with \"calico\".  Supports regular expression syntax.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ServiceCIDRs is the list of Kubernetes service CIDRs that Felix treats as virtual service IPs rather than as external destinations.  In a dual-stack cluster, list one IPv4 and one IPv6 CIDR.  ServiceLoopPrevention applies to these CIDRs, so traffic to an unused service IP within them is dropped or rejected as configured. [Default: unset - Felix derives the service CIDR from its other configuration]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"routeSource": {
+					"mtuIfacePattern": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RouteSource configures where Felix gets its routing information. - WorkloadIPs: use workload endpoints to construct routes. - CalicoIPAM: the default - use IPAM data to construct routes.",
+							Description: "MTUIfacePattern is a regular expression that controls which interfaces Felix should scan in order to calculate the host's MTU. This should not match workload interfaces (usually named cali...).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"routeTableRange": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Calico programs additional Linux route tables for various purposes.  RouteTableRange specifies the indices of the route tables that Calico should use.",
-							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.RouteTableRange"),
-						},
-					},
-					"egressIPSupport": {
+					"tproxyMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPSupport defines three different support modes for egress IP function. [Default: Disabled] - Disabled:                    Egress IP function is disabled. - EnabledPerNamespace:         Egress IP function is enabled and can be configured on a per-namespace basis;\n                               per-pod egress annotations are ignored.\n- EnabledPerNamespaceOrPerPod: Egress IP function is enabled and can be configured per-namespace or per-pod,\n                               with per-pod egress annotations overriding namespace annotations.",
+							Description: "TPROXYMode sets whether traffic is directed through a transparent proxy for further processing or not and how is the proxying done. [Default: Disabled]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"egressIPVXLANPort": {
+					"tproxyPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPVXLANPort is the port number of vxlan tunnel device for egress traffic. [Default: 4790]",
+							Description: "TPROXYPort sets to which port proxied traffic should be redirected. [Default: 16001]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"egressIPVXLANVNI": {
+					"tproxyMark": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPVXLANVNI is the VNI ID of vxlan tunnel device for egress traffic. [Default: 4097]",
+							Description: "TPROXYMark is the mark that Felix sets on traffic that is intercepted by the transparent proxy, so that it is routed to the proxy. Should be a 32 bit hexadecimal number whose bits are all within TPROXYMarkMask. Only used when TPROXYMode is not Disabled. [Default: 0x00000800]",
 							Type:        []string{"integer"},
-							Format:      "int32",
+							Format:      "int64",
 						},
 					},
-					"egressIPRoutingRulePriority": {
+					"tproxyMarkMask": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPRoutingRulePriority controls the priority value to use for the egress IP routing rule. [Default: 100]",
+							Description: "TPROXYMarkMask is the mask that is applied when matching TPROXYMark. [Default: 0x00000800]",
 							Type:        []string{"integer"},
-							Format:      "int32",
+							Format:      "int64",
 						},
 					},
-					"wireguardEnabled": {
+					"nfqueueEnabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardEnabled controls whether Wireguard is enabled. [Default: false]",
+							Description: "NfqueueEnabled is an experimental integration point that, like TPROXYMode, hands traffic to a userspace process; when enabled, Felix sends packets that are allowed by policy to the Linux NFQUEUE given by NfqueueNumber for further processing.  [Default: false]",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"wireguardListeningPort": {
+					"nfqueueNumber": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardListeningPort controls the listening port used by Wireguard. [Default: 51820]",
+							Description: "NfqueueNumber is the NFQUEUE queue number that Felix sends packets to when NfqueueEnabled is true. [Default: 0]",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"wireguardRoutingRulePriority": {
+					"nfqueueBypassForIntraNode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardRoutingRulePriority controls the priority value to use for the Wireguard routing rule. [Default: 99]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "NfqueueBypassForIntraNode, when NfqueueEnabled is true, skips the NFQUEUE for traffic between workloads on the same node.  [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"wireguardInterfaceName": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.FeatureDetectOverrides", "github.com/tigera/api/pkg/apis/projectcalico/v3.ProtoPort", "github.com/tigera/api/pkg/apis/projectcalico/v3.RouteTableRange", "github.com/tigera/api/pkg/lib/numorstring.Port", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_FlowEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardInterfaceName specifies the name to use for the Wireguard interface. [Default: wg.calico]",
+							Description: "The endpoint type, indicating whether this is a Pod, HostEndpoint, NetworkSet, or internet.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"wireguardMTU": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardMTU controls the MTU on the Wireguard interface. See Configuring MTU [Default: 1420]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "The name of the endpoint. Note that this name may actually be a name prefix if flow logs have been aggregated.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"wireguardHostEncryptionEnabled": {
+					"nameIsAggregationPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WireguardHostEncryptionEnabled controls whether Wireguard host-to-host encryption is enabled. [Default: false]",
+							Description: "Whether the name is an aggregation prefix rather than the actual name.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"captureDir": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaptureDir controls directory to store file capture. [Default: /var/log/calico/pcap]",
+							Description: "The namespace of the endpoint.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"captureMaxSizeBytes": {
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_GeneratedReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedReport describes a report artifact produced by a completed report job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaptureMaxSizeBytes controls the max size of a file capture. [Default: 10000000]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"captureRotationSeconds": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaptureRotationSeconds controls the time rotation of a packet capture. [Default: 3600]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"captureMaxFiles": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaptureMaxFiles controls number of rotated capture file to keep. [Default: 2]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard object's metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"awsSrcDstCheck": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set source-destination-check on AWS EC2 instances. Accepted value must be one of \"DoNothing\", \"Enabled\" or \"Disabled\". [Default: DoNothing]",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Specification of the GeneratedReport.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReportSpec"),
 						},
 					},
-					"serviceLoopPrevention": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReportSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_GeneratedReportList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedReportList contains a list of GeneratedReport resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When service IP advertisement is enabled, prevent routing loops to service IPs that are not in use, by dropping or rejecting packets that do not get DNAT'd by kube-proxy. Unless set to \"Disabled\", in which case such routing loops continue to be allowed. [Default: Drop]",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"mtuIfacePattern": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MTUIfacePattern is a regular expression that controls which interfaces Felix should scan in order to calculate the host's MTU. This should not match workload interfaces (usually named cali...).",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tproxyMode": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TPROXYMode sets whether traffic is directed through a transparent proxy for further processing or not and how is the proxying done. [Default: Disabled]",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"tproxyPort": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TPROXYPort sets to which port proxied traffic should be redirected. [Default: 16001]",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReport"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.ProtoPort", "github.com/tigera/api/pkg/apis/projectcalico/v3.RouteTableRange", "github.com/tigera/api/pkg/lib/numorstring.Port", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.GeneratedReport", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_FlowEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_GeneratedReportSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GeneratedReportSpec contains the values of the GeneratedReport.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"reportName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The endpoint type, indicating whether this is a Pod, HostEndpoint, NetworkSet, or internet.",
+							Description: "The name of the GlobalReport that the artifact was generated for.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"reportTypeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the endpoint. Note that this name may actually be a name prefix if flow logs have been aggregated.",
+							Description: "The name of the GlobalReportType used to render the artifact.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nameIsAggregationPrefix": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether the name is an aggregation prefix rather than the actual name.",
-							Type:        []string{"boolean"},
+							Description: "The start time of the period covered by the report.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The end time of the period covered by the report.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"generationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which generation of the report completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"downloadURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The URL from which the report artifact can be downloaded.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"hash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The namespace of the endpoint.",
+							Description: "The hex-encoded SHA-256 hash of the report artifact, used to verify its integrity after download.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kind", "name"},
+				Required: []string{"reportName", "reportTypeName", "startTime", "endTime", "downloadURL"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4582,11 +6420,39 @@ func schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicySpec(ref common.Referen
 							Format:      "",
 						},
 					},
+					"egressGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressGateway is an optional field that directs traffic matched by this policy through the egress gateways it selects.  It is only honoured when Felix's EgressIPSupport is enabled; this is not enforced at admission time because the Felix configuration is a separate resource.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayRef"),
+						},
+					},
+					"endpointTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointTypes limits the policy to the given kinds of endpoint.  If omitted, the policy applies to all endpoint types that its selector matches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"stagedMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StagedMode indicates that the policy is evaluated and its decisions are logged, but not enforced.  This allows the effect of a policy change to be observed before it takes effect. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayRef", "github.com/tigera/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
@@ -4968,24 +6834,61 @@ func schema_pkg_apis_projectcalico_v3_GlobalThreatFeed(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the GlobalThreatFeed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedSpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_GlobalThreatFeedAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GlobalThreatFeedAuth configures the authentication used when fetching a threat feed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the kind of authentication to use, one of \"None\", \"Basic\", \"Bearer\" or \"APIKey\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the GlobalThreatFeed.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedSpec"),
+							Description: "SecretRef references the secret that holds the credentials.  For \"Basic\" auth the secret must contain \"username\" and \"password\" keys; for \"Bearer\" and \"APIKey\" auth it must contain a \"token\" key.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"status": {
+					"headerName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedStatus"),
+							Description: "HeaderName is the name of the header that carries the key when Type is \"APIKey\".  It must be a valid HTTP header field name, that is an RFC 7230 token.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedSpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -5140,6 +7043,33 @@ func schema_pkg_apis_projectcalico_v3_HTTPHeader(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header by name and exact value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_HTTPHeaderSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5170,12 +7100,12 @@ func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) co
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods and Path fields are joined with AND",
+				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods, Path and Headers fields are joined with AND",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"methods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed HTTP Methods (e.g. GET, PUT, etc.) Multiple methods are OR'd together.",
+							Description: "Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed HTTP Methods (e.g. GET, PUT, etc.) Multiple methods are OR'd together.  Each method must be one of the methods defined by RFC 7231 (or PATCH).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5202,11 +7132,25 @@ func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is an optional field that restricts the rule to apply to HTTP requests that carry all of the listed headers with the given values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPath"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch", "github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPath"},
 	}
 }
 
@@ -5267,12 +7211,24 @@ func schema_pkg_apis_projectcalico_v3_HTTPPull(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"fetchTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FetchTimeout is the timeout for each HTTP request made to fetch the feed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth configures how requests made to fetch the feed are authenticated.  If omitted, requests are not authenticated beyond any credentials supplied in Headers.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedAuth"),
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeader", "github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormat"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.GlobalThreatFeedAuth", "github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPHeader", "github.com/tigera/api/pkg/apis/projectcalico/v3.ThreatFeedFormat", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5430,6 +7386,28 @@ func schema_pkg_apis_projectcalico_v3_HostEndpointSpec(ref common.ReferenceCallb
 							},
 						},
 					},
+					"preDNATPolicyNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of policies, each identified as either \"name\" or \"namespace/name\", to apply to traffic through this endpoint before DNAT is performed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"applyOnForward": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates whether the policy applied to this endpoint also applies to traffic that is forwarded through the host.  Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5632,30 +7610,229 @@ func schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref common.ReferenceCallback) c
 					},
 					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allows IPPool to allocate for a specific node by label selector.",
+							Description: "Allows IPPool to allocate for a specific node by label selector.  When set, Calico IPAM only allocates addresses from this pool to nodes that match the selector, for example to give GPU nodes their own CIDR.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"disableNewAllocations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When disableNewAllocations is true, Calico IPAM keeps the existing allocations from this pool but does not make new ones.  Unlike disabled, this allows workloads to be migrated gradually to another pool.  [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"strictAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When strictAffinity is true, Calico IPAM will not borrow addresses from blocks in this pool that are affine to other nodes, even when the blocks affine to a node are exhausted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allocationTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum time that an address allocation from this pool may block before it fails. If not specified, the allocation is retried until it succeeds or the request is cancelled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"reservedBlocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of CIDRs within the pool that Calico IPAM will not use for dynamic address allocation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bgpFilterRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The names of the BGPFilter resources that are applied to the BGP advertisement of this pool's routes.  When more than one filter is referenced, they are applied in the order listed, and the first rule that matches a route determines the action taken.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedUses controls what the IP pool will be used for.  If not specified, the pool may be used for workload and tunnel addresses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority ranks this pool against the other pools that match an allocation request.  Pools with a lower priority value are used first.  If not specified, the pool is used after those that have one.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"ipip": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
 							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.IPIPConfiguration"),
 						},
 					},
-					"nat-outgoing": {
+					"nat-outgoing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"cidr"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.IPIPConfiguration", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPReservation allows certain IP addresses to be reserved (i.e. prevented from being allocated) by Calico IPAM.  Reservations only apply to addresses within Calico IP pools.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the IPReservation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservationSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservationSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPReservationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPReservationList contains a list of IPReservation resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.IPReservation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPReservationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPReservationSpec contains the specification for an IPReservation resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reservedCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedCIDRs is a list of CIDRs and/or IP addresses that Calico IPAM will exclude from new allocations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
-							Type:        []string{"boolean"},
+							Description: "Reason is a free-form description of why the addresses are reserved.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"cidr"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.IPIPConfiguration"},
 	}
 }
 
@@ -5855,6 +8032,31 @@ func schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationSpec(ref commo
 							Format:      "int32",
 						},
 					},
+					"reconcilerPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilerPeriod is the default period to perform reconciliation with the Calico datastore, used by controllers that do not set their own ReconcilerPeriod. [Default: 5m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"workqueueMaxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkqueueMaxRetries is the number of times a controller retries reconciling a resource before dropping it from its workqueue. [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"workqueueBaseDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkqueueBaseDelay is the delay before the first retry of a resource that failed to reconcile. The delay doubles with each further retry, up to WorkqueueMaxDelay. Must not be negative. [Default: 5ms]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"workqueueMaxDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkqueueMaxDelay is the maximum delay between retries of a resource that failed to reconcile. Must not be negative. [Default: 1000s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"controllers": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controllers enables and configures individual Kubernetes controllers",
@@ -6080,6 +8282,19 @@ func schema_pkg_apis_projectcalico_v3_LicenseKeyStatus(ref common.ReferenceCallb
 							},
 						},
 					},
+					"validUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidUntil is the end of the license's grace period, after which the licensed features are disabled.  It is not earlier than Expiry.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State summarises the validity of the license: \"Valid\", \"Expired\" or \"Invalid\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -6201,9 +8416,30 @@ func schema_pkg_apis_projectcalico_v3_ManagedClusterSpec(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"connectionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionTimeout is how long the management cluster waits for a response from the managed cluster before treating the connection as lost.  Must be greater than zero.  [Default: 30s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryInterval is the time the management cluster waits between attempts to reconnect to the managed cluster.  Must not be negative.  [Default: 5s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of reconnection attempts made before the managed cluster is reported as disconnected.  [Default: 3]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -6496,6 +8732,20 @@ func schema_pkg_apis_projectcalico_v3_NetworkPolicySpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"endpointType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointType limits the policy to the given kind of endpoint.  Only \"WorkloadEndpoint\" is allowed because host endpoints are not namespaced and can only be selected by global policies.  If omitted, the policy applies to all endpoint types that its selector matches.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stagedMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StagedMode indicates that the policy is evaluated and its decisions are logged, but not enforced.  This allows the effect of a policy change to be observed before it takes effect. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -6880,11 +9130,30 @@ func schema_pkg_apis_projectcalico_v3_PacketCaptureSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Defines the time from which this PacketCapture will start capturing traffic.  If omitted, the capture starts as soon as the resource is created.  Cannot be combined with CronSchedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Defines the time at which this PacketCapture will stop capturing traffic.  If omitted, the capture runs until the resource is deleted.  Must be after StartTime when both are set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"cronSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A standard five-field cron expression, such as \"0 2 * * 0\", that defines when the capture is started.  Cannot be combined with StartTime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.PacketCaptureRule"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.PacketCaptureRule", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6937,6 +9206,169 @@ func schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_PolicyRecommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyRecommendation configures the generation of recommended network policies for a set of endpoints, based on the traffic observed to and from them.  The recommendations are reported in its status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the PolicyRecommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the PolicyRecommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationSpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PolicyRecommendationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyRecommendationList contains a list of PolicyRecommendation resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.PolicyRecommendation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PolicyRecommendationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyRecommendationSpec contains the specification for a PolicyRecommendation resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is an expression used to pick out the endpoints that policies are recommended for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector is an expression used to pick out the namespaces of the endpoints that policies are recommended for.  If omitted, endpoints in all namespaces are considered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stabilizationPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StabilizationPeriod is how long the observed traffic patterns of an endpoint must remain unchanged before a recommendation is generated for it.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PolicyRecommendationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyRecommendationStatus contains the policies recommended by a PolicyRecommendation resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recommendations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendations is the set of policies that are currently recommended.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.RecommendedPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.RecommendedPolicy"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7130,7 +9562,7 @@ func schema_pkg_apis_projectcalico_v3_ProtoPort(ref common.ReferenceCallback) co
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProtoPort is combination of protocol, port, and CIDR. Protocol and port must be specified.",
+				Description: "ProtoPort is combination of protocol, port, and CIDR. Protocol and port must be specified, except for ICMP entries, which specify an ICMP type (and optionally code) in place of the port.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"protocol": {
@@ -7140,50 +9572,118 @@ func schema_pkg_apis_projectcalico_v3_ProtoPort(ref common.ReferenceCallback) co
 							Format:  "",
 						},
 					},
-					"port": {
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"net": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"icmpType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMPType restricts an \"icmp\" or \"icmpv6\" entry to a single ICMP type.  May only be set when Protocol is \"icmp\" or \"icmpv6\", in which case Port must be zero.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"icmpCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMPCode restricts an \"icmp\" or \"icmpv6\" entry to a single ICMP code.  May only be set when Protocol is \"icmp\" or \"icmpv6\".",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"protocol", "port"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_Pull(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: use PullInterval instead.  Ignored if PullInterval is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pullInterval": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "PullInterval is how often the feed is refreshed.  [Default: 24h]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"net": {
+					"http": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPull"),
 						},
 					},
 				},
-				Required: []string{"protocol", "port"},
+				Required: []string{"http"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPull", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_Pull(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_RecommendedPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RecommendedPolicy is a single recommended network policy.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"period": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name is the name that the recommended policy would be created with.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"http": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPull"),
+							Description: "Namespace is the namespace that the recommended policy would be created in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the recommended policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.NetworkPolicySpec"),
+						},
+					},
+					"confidence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confidence is the confidence in the recommendation, from 0 (none) to 1 (certain).",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
 				},
-				Required: []string{"http"},
+				Required: []string{"name", "namespace", "spec", "confidence"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.HTTPPull"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.NetworkPolicySpec"},
 	}
 }
 
@@ -7602,6 +10102,35 @@ func schema_pkg_apis_projectcalico_v3_ReportData(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_ReportInventoryConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReportInventoryConfig defines which resources are included in the inventory section of a report.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources lists the resources that will be included in the inventory in the ReportData.  Blank fields in the listed AuditResource structs are treated as wildcards.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tigera/api/pkg/apis/projectcalico/v3.AuditResource"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditResource"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_ReportJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7856,11 +10385,30 @@ func schema_pkg_apis_projectcalico_v3_ReportTypeSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"inventoryConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "What resource inventory data should be included in the report. If not specified, the report will contain no inventory data.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.ReportInventoryConfig"),
+						},
+					},
+					"endpointsSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The default set of endpoints that are in-scope for reports of this type. This may be further restricted by the EndpointsSelection of the Report.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EndpointsSelection"),
+						},
+					},
+					"uiEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether reports of this type are displayed by the UI. [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditEventsSelection", "github.com/tigera/api/pkg/apis/projectcalico/v3.ReportTemplate"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.AuditEventsSelection", "github.com/tigera/api/pkg/apis/projectcalico/v3.EndpointsSelection", "github.com/tigera/api/pkg/apis/projectcalico/v3.ReportInventoryConfig", "github.com/tigera/api/pkg/apis/projectcalico/v3.ReportTemplate"},
 	}
 }
 
@@ -7995,6 +10543,13 @@ func schema_pkg_apis_projectcalico_v3_Rule(ref common.ReferenceCallback) common.
 							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EntityRule"),
 						},
 					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector is an optional field that contains a selector expression.  When set, the rule only matches traffic whose peer endpoint - the source for ingress rules and the destination for egress rules - is in a namespace whose labels match the selector.  This allows a namespaced NetworkPolicy to match endpoints in other namespaces without resorting to a GlobalNetworkPolicy.\n\nNeither selector takes precedence over the other: if the peer EntityRule also sets NamespaceSelector, the endpoint's namespace must match both selectors (see PeerNamespaceSelector), and the EntityRule's Selector is evaluated within the namespaces selected here rather than the policy's own namespace.  The other EntityRule is unaffected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"http": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HTTP contains match criteria that apply to HTTP requests.",
@@ -8191,11 +10746,18 @@ func schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicy(ref common.Refer
 							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicySpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the staged policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.StagedPolicyStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicySpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.StagedPolicyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -8360,11 +10922,32 @@ func schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicySpec(ref common.R
 							Format:      "",
 						},
 					},
+					"egressGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressGateway is an optional field that directs traffic matched by this policy through the egress gateways it selects.  It is only honoured when Felix's EgressIPSupport is enabled; this is not enforced at admission time because the Felix configuration is a separate resource.",
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayRef"),
+						},
+					},
+					"endpointTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointTypes limits the policy to the given kinds of endpoint.  If omitted, the policy applies to all endpoint types that its selector matches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.EgressGatewayRef", "github.com/tigera/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
@@ -8566,11 +11149,18 @@ func schema_pkg_apis_projectcalico_v3_StagedNetworkPolicy(ref common.ReferenceCa
 							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the staged policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tigera/api/pkg/apis/projectcalico/v3.StagedPolicyStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/tigera/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec", "github.com/tigera/api/pkg/apis/projectcalico/v3.StagedPolicyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -8707,6 +11297,13 @@ func schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref common.Referen
 							Format:      "",
 						},
 					},
+					"endpointType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointType limits the policy to the given kind of endpoint.  Only \"WorkloadEndpoint\" is allowed because host endpoints are not namespaced and can only be selected by global policies.  If omitted, the policy applies to all endpoint types that its selector matches.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8715,6 +11312,33 @@ func schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_StagedPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StagedPolicyStatus reports the progress of a staged policy towards being promoted to an enforced policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activePolicyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActivePolicyRef is the name of the enforced policy that this staged policy was promoted to, or that it would replace if promoted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stagingStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StagingStatus is a short, human readable description of the state of the staged policy, as reported by the controller that manages promotion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_ThreatFeedFormat(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8826,6 +11450,35 @@ func schema_pkg_apis_projectcalico_v3_ThreatFeedFormatNewlineDelimited(ref commo
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_ThreatFeedRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ThreatFeedRef identifies a threat feed by name and, optionally, namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the threat feed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The namespace of the threat feed.  If omitted, the feed is assumed to be a GlobalThreatFeed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_Tier(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8927,11 +11580,25 @@ func schema_pkg_apis_projectcalico_v3_TierSpec(ref common.ReferenceCallback) com
 				Properties: map[string]spec.Schema{
 					"order": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Order is an optional field that specifies the order in which the tier is applied. Tiers with higher \"order\" are applied after those with lower order.  If the order is omitted, it may be considered to be \"infinite\" - i.e. the tier will be applied last.  Tiers with identical order will be applied in alphanumerical order based on the Tier \"Name\".",
+							Description: "Order is an optional field that specifies the order in which the tier is applied. Tiers with higher \"order\" are applied after those with lower order.  If the order is omitted, it may be considered to be \"infinite\" - i.e. the tier will be applied last.  Tiers with identical order will be applied in alphanumerical order based on the Tier \"Name\".  If set, the order must be a positive number.",
 							Type:        []string{"number"},
 							Format:      "double",
 						},
 					},
+					"defaultAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultAction specifies the action applied to traffic that reaches the end of the tier without being matched by any of its policies.  If omitted, the traffic is passed to the next tier.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"passMatchedFlowsToNextTier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PassMatchedFlowsToNextTier specifies whether flows that are explicitly matched by a Pass rule in this tier continue to be evaluated by the subsequent tiers.  [Default: true]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8959,6 +11626,90 @@ func schema_pkg_apis_projectcalico_v3_WorkloadEndpointControllerConfig(ref commo
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_cronField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "cronField describes the values allowed in one field of a cron schedule.  Names, if set, are the three letter names that may be used in place of the values starting at min.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "min", "max", "names"},
+			},
+		},
+	}
+}
+
+func schema_api_pkg_lib_numorstring_NamedPortOrNumber(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedPortOrNumber is a type that can hold either a single numeric port or a named port.  When used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type. Unlike Port, it does not represent port ranges.\n\n    - A numeric port must be in the range 1-65535.\n    - A named port must be a valid DNS label of no more than 15 characters that contains at\n      least one letter, for example \"http\" or \"metrics-1\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"numVal": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"strVal": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"type", "numVal", "strVal"},
+			},
+		},
+	}
+}
+
 func schema_api_pkg_lib_numorstring_Port(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{